@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	"os"
 
@@ -29,8 +30,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
+	"github.com/likecoin/likechain/x/circuit"
 	govwrap "github.com/likecoin/likechain/x/gov"
+	"github.com/likecoin/likechain/x/iscn"
+	"github.com/likecoin/likechain/x/nameservice"
+	"github.com/likecoin/likechain/x/reward"
 	stakingwrap "github.com/likecoin/likechain/x/staking"
+	"github.com/likecoin/likechain/x/stream"
+	"github.com/likecoin/likechain/x/subscription"
 	"github.com/likecoin/likechain/x/whitelist"
 )
 
@@ -60,6 +67,12 @@ var (
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
 		whitelist.AppModuleBasic{},
+		nameservice.AppModuleBasic{},
+		reward.AppModuleBasic{},
+		iscn.AppModuleBasic{},
+		stream.AppModuleBasic{},
+		subscription.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -70,6 +83,8 @@ var (
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
+		stream.ModuleName:         nil,
+		nameservice.ModuleName:    {supply.Burner},
 	}
 )
 
@@ -97,17 +112,23 @@ type LikeApp struct {
 	tkeys map[string]*sdk.TransientStoreKey
 
 	// keepers
-	accountKeeper   auth.AccountKeeper
-	bankKeeper      bank.Keeper
-	supplyKeeper    supply.Keeper
-	stakingKeeper   staking.Keeper
-	slashingKeeper  slashing.Keeper
-	mintKeeper      mint.Keeper
-	distrKeeper     distr.Keeper
-	govKeeper       gov.Keeper
-	crisisKeeper    crisis.Keeper
-	paramsKeeper    params.Keeper
-	whitelistKeeper whitelist.Keeper
+	accountKeeper      auth.AccountKeeper
+	bankKeeper         bank.Keeper
+	supplyKeeper       supply.Keeper
+	stakingKeeper      staking.Keeper
+	slashingKeeper     slashing.Keeper
+	mintKeeper         mint.Keeper
+	distrKeeper        distr.Keeper
+	govKeeper          gov.Keeper
+	crisisKeeper       crisis.Keeper
+	paramsKeeper       params.Keeper
+	whitelistKeeper    whitelist.Keeper
+	nameKeeper         nameservice.Keeper
+	rewardKeeper       reward.Keeper
+	iscnKeeper         iscn.Keeper
+	streamKeeper       stream.Keeper
+	subscriptionKeeper subscription.Keeper
+	circuitKeeper      circuit.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -126,7 +147,8 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	keys := sdk.NewKVStoreKeys(
 		bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, whitelist.StoreKey,
+		gov.StoreKey, params.StoreKey, whitelist.StoreKey, nameservice.StoreKey,
+		reward.StoreKey, iscn.StoreKey, stream.StoreKey, subscription.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -149,6 +171,10 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 	whitelistSubspace := app.paramsKeeper.Subspace(whitelist.DefaultParamspace)
+	nameSubspace := app.paramsKeeper.Subspace(nameservice.DefaultParamspace)
+	rewardSubspace := app.paramsKeeper.Subspace(reward.DefaultParamspace)
+	circuitSubspace := app.paramsKeeper.Subspace(circuit.DefaultParamspace)
+	subscriptionSubspace := app.paramsKeeper.Subspace(subscription.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
@@ -166,6 +192,12 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
 	app.whitelistKeeper = whitelist.NewKeeper(app.cdc, keys[whitelist.StoreKey], whitelistSubspace, whitelist.DefaultCodespace)
+	app.nameKeeper = nameservice.NewKeeper(app.cdc, keys[nameservice.StoreKey], nameSubspace, app.supplyKeeper, nameservice.DefaultCodespace)
+	app.rewardKeeper = reward.NewKeeper(app.cdc, keys[reward.StoreKey], rewardSubspace, app.bankKeeper, reward.DefaultCodespace)
+	app.iscnKeeper = iscn.NewKeeper(app.cdc, keys[iscn.StoreKey], iscn.DefaultCodespace)
+	app.streamKeeper = stream.NewKeeper(app.cdc, keys[stream.StoreKey], app.supplyKeeper, app.ModuleAccountAddrs(), stream.DefaultCodespace)
+	app.subscriptionKeeper = subscription.NewKeeper(app.cdc, keys[subscription.StoreKey], subscriptionSubspace, app.bankKeeper, subscription.DefaultCodespace)
+	app.circuitKeeper = circuit.NewKeeper(circuitSubspace, circuit.DefaultCodespace)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
@@ -198,6 +230,12 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		stakingwrap.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.whitelistKeeper),
 		whitelist.NewAppModule(app.whitelistKeeper),
+		nameservice.NewAppModule(app.nameKeeper),
+		reward.NewAppModule(app.rewardKeeper),
+		iscn.NewAppModule(app.iscnKeeper),
+		stream.NewAppModule(app.streamKeeper),
+		subscription.NewAppModule(app.subscriptionKeeper),
+		circuit.NewAppModule(app.circuitKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -205,14 +243,17 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, stream.ModuleName, subscription.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
+	// properly initialized with tokens from genesis accounts. Crisis must occur
+	// after every module with invariants, as it asserts them on init.
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName, whitelist.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
+		mint.ModuleName, supply.ModuleName, nameservice.ModuleName, reward.ModuleName,
+		iscn.ModuleName, stream.ModuleName, subscription.ModuleName, circuit.ModuleName,
+		crisis.ModuleName, genutil.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -225,7 +266,10 @@ func NewLikeApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest b
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(circuit.NewAnteHandler(
+		app.circuitKeeper,
+		auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer),
+	))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -253,6 +297,17 @@ func (app *LikeApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	var genesisState simapp.GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
 
+	// reject a malformed genesis before any module is initialized, skipping
+	// the modules InitGenesis skips, like genutil in an exported genesis
+	for _, b := range ModuleBasics {
+		if genesisState[b.Name()] == nil {
+			continue
+		}
+		if err := b.ValidateGenesis(genesisState[b.Name()]); err != nil {
+			panic(fmt.Sprintf("invalid genesis for module %s: %v", b.Name(), err))
+		}
+	}
+
 	return app.mm.InitGenesis(ctx, genesisState)
 }
 
@@ -265,7 +320,7 @@ func (app *LikeApp) LoadHeight(height int64) error {
 func (app *LikeApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
 	for acc := range maccPerms {
-		modAccAddrs[app.supplyKeeper.GetModuleAddress(acc).String()] = true
+		modAccAddrs[supply.NewModuleAddress(acc).String()] = true
 	}
 
 	return modAccAddrs
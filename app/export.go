@@ -161,4 +161,51 @@ func (app *LikeApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []st
 			return false
 		},
 	)
+
+	/* Handle nameservice state. */
+
+	// rebase expiry heights on the new chain, dropping expired names since an
+	// expiry of 0 would make them permanent
+	for _, record := range app.nameKeeper.GetAllNameRecords(ctx) {
+		if record.Expiry == 0 {
+			continue
+		}
+		if record.IsExpired(height) {
+			app.nameKeeper.DeleteNameRecord(ctx, record)
+			continue
+		}
+		record.Expiry -= height
+		app.nameKeeper.SetNameRecord(ctx, record)
+	}
+
+	/* Handle stream state. */
+
+	// rebase start and end heights on the new chain, keeping the elapsed and
+	// remaining blocks of every stream
+	for _, stream := range app.streamKeeper.GetAllStreams(ctx) {
+		app.streamKeeper.DeleteStream(ctx, stream)
+		stream.StartHeight -= height
+		stream.EndHeight -= height
+		app.streamKeeper.SetStream(ctx, stream)
+	}
+
+	/* Handle subscription state. */
+
+	// rebase next payment and paused heights on the new chain, rebuilding the
+	// due payment and paused subscription indices. Heights already passed
+	// become 0, so overdue payments are made in the first block.
+	for _, sub := range app.subscriptionKeeper.GetAllSubscriptions(ctx) {
+		app.subscriptionKeeper.DeleteSubscription(ctx, sub)
+		sub.NextPaymentHeight -= height
+		if sub.NextPaymentHeight < 0 {
+			sub.NextPaymentHeight = 0
+		}
+		if sub.Paused {
+			sub.PausedHeight -= height
+			if sub.PausedHeight < 0 {
+				sub.PausedHeight = 0
+			}
+		}
+		app.subscriptionKeeper.SetSubscription(ctx, sub)
+	}
 }
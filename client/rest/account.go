@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+)
+
+const maxAccountsPerQuery = 100
+
+type QueryAccountsReq struct {
+	Addresses []sdk.AccAddress `json:"addresses"`
+}
+
+func registerAccountRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/auth/accounts/query",
+		queryAccountsHandlerFn(cliCtx),
+	).Methods("POST")
+}
+
+// queryAccountsHandlerFn returns the accounts in the same order as the
+// requested addresses, all read at the same height. Non-existing accounts are
+// returned as empty accounts, the same as GET /auth/accounts/{address}.
+func queryAccountsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req QueryAccountsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		if len(req.Addresses) > maxAccountsPerQuery {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				fmt.Sprintf("too many addresses, maximum is %d", maxAccountsPerQuery))
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		if cliCtx.Height == 0 {
+			// pin all queries to the latest height before reading any account
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			status, err := node.Status()
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			cliCtx = cliCtx.WithHeight(status.SyncInfo.LatestBlockHeight)
+		}
+
+		accounts := make([]exported.Account, 0, len(req.Addresses))
+		for _, addr := range req.Addresses {
+			accGetter := auth.NewAccountRetriever(cliCtx)
+			account, err := accGetter.GetAccount(addr)
+			if err != nil {
+				if err := accGetter.EnsureExists(addr); err != nil {
+					accounts = append(accounts, &auth.BaseAccount{})
+					continue
+				}
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			accounts = append(accounts, account)
+		}
+
+		rest.PostProcessResponse(w, cliCtx, accounts)
+	}
+}
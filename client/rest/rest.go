@@ -0,0 +1,14 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// RegisterRoutes registers LikeChain specific REST handlers which do not
+// belong to any module to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerTxRoutes(cliCtx, r)
+	registerAccountRoutes(cliCtx, r)
+}
@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+const (
+	TxStatusCommitted = "committed"
+	TxStatusPending   = "pending"
+	TxStatusNotFound  = "not_found"
+	TxStatusUnknown   = "unknown"
+
+	// Tendermint caps the number of unconfirmed txs returned in one call
+	maxUnconfirmedTxs = 100
+)
+
+type TxStatus struct {
+	Status string `json:"status"`
+	Height int64  `json:"height,omitempty"`
+	Code   uint32 `json:"code,omitempty"`
+}
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/txs/{hash}/status",
+		txStatusHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+// txStatusHandlerFn distinguishes txs committed in a block, txs still waiting
+// in the mempool of the connected node and unknown txs. If the mempool holds
+// more txs than a single call returns, a tx missing from both is reported as
+// unknown instead of not found.
+func txStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		hash, err := hex.DecodeString(mux.Vars(r)["hash"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		node, err := cliCtx.GetNode()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		resTx, err := node.Tx(hash, false)
+		if err == nil {
+			rest.PostProcessResponse(w, cliCtx, TxStatus{
+				Status: TxStatusCommitted,
+				Height: resTx.Height,
+				Code:   resTx.TxResult.Code,
+			})
+			return
+		}
+		if !isTxNotFound(err, hash) {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		resUnconfirmed, err := node.UnconfirmedTxs(maxUnconfirmedTxs)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		for _, tx := range resUnconfirmed.Txs {
+			if bytes.Equal(tx.Hash(), hash) {
+				rest.PostProcessResponse(w, cliCtx, TxStatus{Status: TxStatusPending})
+				return
+			}
+		}
+
+		if resUnconfirmed.Total > resUnconfirmed.Count {
+			rest.PostProcessResponse(w, cliCtx, TxStatus{Status: TxStatusUnknown})
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, TxStatus{Status: TxStatusNotFound})
+	}
+}
+
+// isTxNotFound reports whether err is Tendermint's error for a tx missing from
+// the tx index, as opposed to an RPC failure or a disabled indexer
+func isTxNotFound(err error, hash []byte) bool {
+	return strings.Contains(err.Error(), fmt.Sprintf("Tx (%X) not found", hash))
+}
@@ -0,0 +1,515 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	tmconfig "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/genaccounts"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/likecoin/likechain/x/nameservice"
+)
+
+const (
+	flagNodeDirPrefix     = "node-dir-prefix"
+	flagNumValidators     = "v"
+	flagOutputDir         = "output-dir"
+	flagNodeDaemonHome    = "node-daemon-home"
+	flagNodeCLIHome       = "node-cli-home"
+	flagStartingIPAddress = "starting-ip-address"
+)
+
+const (
+	nodeDirPerm = 0755
+
+	// testnetDenom replaces the SDK default bond denom in the genesis
+	testnetDenom = "nanolike"
+
+	// dockerImage is the image built by docker/app/build.sh
+	dockerImage = "likechain/likechain"
+)
+
+// testnetCmd initializes all files for a local multi-validator testnet
+func testnetCmd(ctx *server.Context, cdc *codec.Codec,
+	mbm module.BasicManager, genAccIterator genutiltypes.GenesisAccountsIterator,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "testnet",
+		Short: "Initialize files for a LikeChain testnet",
+		Long: `testnet will create "v" number of directories and populate each with
+necessary files (private validator, genesis, config, etc.), using nanolike as
+the bond denom. A docker-compose.yml running each node at its IP address in
+the likechain/likechain image is written to the output directory.
+
+Note, strict routability for addresses is turned off in the config file.
+
+Example:
+	liked testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+	`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := ctx.Config
+
+			outputDir := viper.GetString(flagOutputDir)
+			chainID := viper.GetString(client.FlagChainID)
+			minGasPrices := viper.GetString(server.FlagMinGasPrices)
+			nodeDirPrefix := viper.GetString(flagNodeDirPrefix)
+			nodeDaemonHome := viper.GetString(flagNodeDaemonHome)
+			nodeCLIHome := viper.GetString(flagNodeCLIHome)
+			startingIPAddress := viper.GetString(flagStartingIPAddress)
+			numValidators := viper.GetInt(flagNumValidators)
+
+			return initTestnet(cmd, config, cdc, mbm, genAccIterator, outputDir, chainID,
+				minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators)
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4,
+		"Number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./mytestnet",
+		"Directory to store initialization data for the testnet")
+	cmd.Flags().String(flagNodeDirPrefix, "node",
+		"Prefix the directory name for each node with (node results in node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, ".liked",
+		"Home directory of the node's daemon configuration")
+	cmd.Flags().String(flagNodeCLIHome, ".likecli",
+		"Home directory of the node's cli configuration")
+	cmd.Flags().String(flagStartingIPAddress, "192.168.10.2",
+		"Starting IP address (192.168.10.2 results in persistent peers list ID0@192.168.10.2:26656, ID1@192.168.10.3:26656, ...)")
+	cmd.Flags().String(
+		client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
+	cmd.Flags().String(
+		server.FlagMinGasPrices, fmt.Sprintf("1.0%s", testnetDenom),
+		"Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 1.0nanolike)")
+	return cmd
+}
+
+func initTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
+	mbm module.BasicManager, genAccIterator genutiltypes.GenesisAccountsIterator,
+	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome,
+	nodeCLIHome, startingIPAddress string, numValidators int) error {
+
+	if chainID == "" {
+		chainID = "chain-" + cmn.RandStr(6)
+	}
+
+	if startingIPAddress != "" {
+		if err := checkDockerSubnet(startingIPAddress, numValidators); err != nil {
+			return err
+		}
+	}
+
+	monikers := make([]string, numValidators)
+	nodeIDs := make([]string, numValidators)
+	valPubKeys := make([]crypto.PubKey, numValidators)
+
+	likeConfig := srvconfig.DefaultConfig()
+	likeConfig.MinGasPrices = minGasPrices
+
+	var (
+		accs     []genaccounts.GenesisAccount
+		genFiles []string
+	)
+
+	// generate private keys, node IDs, and initial transactions
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		clientDir := filepath.Join(outputDir, nodeDirName, nodeCLIHome)
+		gentxsDir := filepath.Join(outputDir, "gentxs")
+
+		config.SetRoot(nodeDir)
+		config.RPC.ListenAddress = "tcp://0.0.0.0:26657"
+		config.P2P.AddrBookStrict = false
+
+		if err := os.MkdirAll(filepath.Join(nodeDir, "config"), nodeDirPerm); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		if err := os.MkdirAll(clientDir, nodeDirPerm); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		monikers[i] = nodeDirName
+		config.Moniker = nodeDirName
+
+		ip, err := getIP(i, startingIPAddress)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		nodeIDs[i], valPubKeys[i], err = genutil.InitializeNodeValidatorFiles(config)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		memo := fmt.Sprintf("%s@%s:26656", nodeIDs[i], ip)
+		genFiles = append(genFiles, config.GenesisFile())
+
+		buf := bufio.NewReader(cmd.InOrStdin())
+		prompt := fmt.Sprintf(
+			"Password for account '%s' (default %s):", nodeDirName, client.DefaultKeyPass,
+		)
+
+		keyPass, err := input.GetPassword(prompt, buf)
+		if err != nil && keyPass != "" {
+			// either reading the password from STDIN failed, or the given
+			// password is too short
+			return err
+		}
+
+		if keyPass == "" {
+			keyPass = client.DefaultKeyPass
+		}
+
+		addr, secret, err := server.GenerateSaveCoinKey(clientDir, nodeDirName, keyPass, true)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		info := map[string]string{"secret": secret}
+
+		cliPrint, err := json.Marshal(info)
+		if err != nil {
+			return err
+		}
+
+		// save private key seed words
+		if err := writeFile(fmt.Sprintf("%v.json", "key_seed"), clientDir, cliPrint); err != nil {
+			return err
+		}
+
+		accTokens := sdk.TokensFromConsensusPower(1000)
+		accs = append(accs, genaccounts.GenesisAccount{
+			Address: addr,
+			Coins:   sdk.NewCoins(sdk.NewCoin(testnetDenom, accTokens)),
+		})
+
+		valTokens := sdk.TokensFromConsensusPower(100)
+		msg := staking.NewMsgCreateValidator(
+			sdk.ValAddress(addr),
+			valPubKeys[i],
+			sdk.NewCoin(testnetDenom, valTokens),
+			staking.NewDescription(nodeDirName, "", "", ""),
+			staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			sdk.OneInt(),
+		)
+		kb, err := keys.NewKeyBaseFromDir(clientDir)
+		if err != nil {
+			return err
+		}
+		tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, []auth.StdSignature{}, memo)
+		txBldr := auth.NewTxBuilderFromCLI().WithChainID(chainID).WithMemo(memo).WithKeybase(kb)
+
+		signedTx, err := txBldr.SignStdTx(nodeDirName, keyPass, tx, false)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		txBytes, err := cdc.MarshalJSON(signedTx)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		// gather gentxs folder
+		if err := writeFile(fmt.Sprintf("%v.json", nodeDirName), gentxsDir, txBytes); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+
+		likeConfigFilePath := filepath.Join(nodeDir, "config/app.toml")
+		srvconfig.WriteConfigFile(likeConfigFilePath, likeConfig)
+	}
+
+	if err := initGenFiles(cdc, mbm, chainID, accs, genFiles, numValidators); err != nil {
+		return err
+	}
+
+	err := collectGenFiles(
+		cdc, config, chainID, monikers, nodeIDs, valPubKeys, numValidators,
+		outputDir, nodeDirPrefix, nodeDaemonHome, genAccIterator,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the compose file needs fixed IPs, which are unknown when using the
+	// external IP of this machine
+	if startingIPAddress != "" {
+		err = writeDockerCompose(outputDir, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
+	return nil
+}
+
+func initGenFiles(cdc *codec.Codec, mbm module.BasicManager, chainID string,
+	accs []genaccounts.GenesisAccount, genFiles []string, numValidators int) error {
+
+	appGenState := mbm.DefaultGenesis()
+	setGenesisDenom(cdc, appGenState, testnetDenom)
+
+	// set the accounts in the genesis state
+	appGenState = genaccounts.SetGenesisStateInAppState(cdc, appGenState, accs)
+
+	appGenStateJSON, err := codec.MarshalJSONIndent(cdc, appGenState)
+	if err != nil {
+		return err
+	}
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:    chainID,
+		AppState:   appGenStateJSON,
+		Validators: nil,
+	}
+
+	// generate empty genesis files for each validator and save
+	for i := 0; i < numValidators; i++ {
+		if err := genDoc.SaveAs(genFiles[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setGenesisDenom replaces the SDK default bond denom in the default genesis
+// of the modules holding a denom in their params
+func setGenesisDenom(cdc *codec.Codec, appGenState map[string]json.RawMessage, denom string) {
+	var stakingGenState staking.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[staking.ModuleName], &stakingGenState)
+	stakingGenState.Params.BondDenom = denom
+	appGenState[staking.ModuleName] = cdc.MustMarshalJSON(stakingGenState)
+
+	var mintGenState mint.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[mint.ModuleName], &mintGenState)
+	mintGenState.Params.MintDenom = denom
+	appGenState[mint.ModuleName] = cdc.MustMarshalJSON(mintGenState)
+
+	var crisisGenState crisis.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[crisis.ModuleName], &crisisGenState)
+	crisisGenState.ConstantFee.Denom = denom
+	appGenState[crisis.ModuleName] = cdc.MustMarshalJSON(crisisGenState)
+
+	var govGenState gov.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[gov.ModuleName], &govGenState)
+	minDeposit := sdk.NewCoins()
+	for _, coin := range govGenState.DepositParams.MinDeposit {
+		minDeposit = minDeposit.Add(sdk.NewCoins(sdk.NewCoin(denom, coin.Amount)))
+	}
+	govGenState.DepositParams.MinDeposit = minDeposit
+	appGenState[gov.ModuleName] = cdc.MustMarshalJSON(govGenState)
+
+	var nameGenState nameservice.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[nameservice.ModuleName], &nameGenState)
+	registrationFee := sdk.NewCoins()
+	for _, coin := range nameGenState.Params.RegistrationFee {
+		registrationFee = registrationFee.Add(sdk.NewCoins(sdk.NewCoin(denom, coin.Amount)))
+	}
+	nameGenState.Params.RegistrationFee = registrationFee
+	appGenState[nameservice.ModuleName] = cdc.MustMarshalJSON(nameGenState)
+}
+
+func collectGenFiles(
+	cdc *codec.Codec, config *tmconfig.Config, chainID string,
+	monikers, nodeIDs []string, valPubKeys []crypto.PubKey,
+	numValidators int, outputDir, nodeDirPrefix, nodeDaemonHome string,
+	genAccIterator genutiltypes.GenesisAccountsIterator) error {
+
+	var appState json.RawMessage
+	genTime := tmtime.Now()
+
+	for i := 0; i < numValidators; i++ {
+		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
+		nodeDir := filepath.Join(outputDir, nodeDirName, nodeDaemonHome)
+		gentxsDir := filepath.Join(outputDir, "gentxs")
+		moniker := monikers[i]
+		config.Moniker = nodeDirName
+
+		config.SetRoot(nodeDir)
+
+		nodeID, valPubKey := nodeIDs[i], valPubKeys[i]
+		initCfg := genutil.NewInitConfig(chainID, gentxsDir, moniker, nodeID, valPubKey)
+
+		genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		nodeAppState, err := genutil.GenAppStateFromConfig(cdc, config, initCfg, *genDoc, genAccIterator)
+		if err != nil {
+			return err
+		}
+
+		if appState == nil {
+			// set the canonical application state (they should not differ)
+			appState = nodeAppState
+		}
+
+		genFile := config.GenesisFile()
+
+		// overwrite each validator's genesis file to have a canonical genesis time
+		if err := genutil.ExportGenesisFileWithTime(genFile, chainID, nil, appState, genTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var dockerComposeTemplate = template.Must(template.New("docker-compose").Parse(`version: "3.6"
+services:
+{{- range .Nodes }}
+    {{ .Name }}:
+        image: {{ $.Image }}
+        container_name: likechain_testnet_{{ .Name }}
+        volumes:
+            - ./{{ .Name }}/{{ $.DaemonHome }}:/likechain/.liked
+            - ./{{ .Name }}/{{ $.CLIHome }}:/likechain/.likecli
+        ports:
+            - "{{ .P2PPort }}-{{ .RPCPort }}:26656-26657"
+        networks:
+            testnet:
+                ipv4_address: {{ .IP }}
+        restart: always
+        command: ["liked", "start", "--home", "/likechain/.liked"]
+{{- end }}
+networks:
+    testnet:
+        ipam:
+            config:
+                - subnet: {{ .Subnet }}
+`))
+
+type dockerComposeNode struct {
+	Name    string
+	IP      string
+	P2PPort int
+	RPCPort int
+}
+
+// writeDockerCompose writes a docker-compose.yml running each node at the IP
+// in the persistent peers list, exposing node i on host ports 26656+2i and
+// 26657+2i
+func writeDockerCompose(outputDir, nodeDirPrefix, nodeDaemonHome, nodeCLIHome,
+	startingIPAddress string, numValidators int) error {
+
+	nodes := make([]dockerComposeNode, numValidators)
+	for i := 0; i < numValidators; i++ {
+		ip, err := calculateIP(startingIPAddress, i)
+		if err != nil {
+			return err
+		}
+		nodes[i] = dockerComposeNode{
+			Name:    fmt.Sprintf("%s%d", nodeDirPrefix, i),
+			IP:      ip,
+			P2PPort: 26656 + 2*i,
+			RPCPort: 26657 + 2*i,
+		}
+	}
+
+	mask := net.CIDRMask(24, 32)
+	subnet := net.IPNet{IP: net.ParseIP(startingIPAddress).To4().Mask(mask), Mask: mask}
+
+	var buf bytes.Buffer
+	err := dockerComposeTemplate.Execute(&buf, map[string]interface{}{
+		"Image":      dockerImage,
+		"DaemonHome": nodeDaemonHome,
+		"CLIHome":    nodeCLIHome,
+		"Nodes":      nodes,
+		"Subnet":     subnet.String(),
+	})
+	if err != nil {
+		return err
+	}
+	return cmn.WriteFile(filepath.Join(outputDir, "docker-compose.yml"), buf.Bytes(), 0644)
+}
+
+// checkDockerSubnet checks that the node IPs fit in the /24 of the starting
+// IP used as the docker subnet, where docker takes .1 as the gateway and .255
+// is the broadcast address, which is why the default starting IP is .2
+func checkDockerSubnet(startingIPAddr string, numValidators int) error {
+	ipv4 := net.ParseIP(startingIPAddr).To4()
+	if ipv4 == nil {
+		return fmt.Errorf("%v: non ipv4 address", startingIPAddr)
+	}
+	if ipv4[3] < 2 || int(ipv4[3])+numValidators-1 > 254 {
+		return fmt.Errorf("%d nodes starting at %s do not fit in .2 to .254 of the docker subnet",
+			numValidators, startingIPAddr)
+	}
+	return nil
+}
+
+func getIP(i int, startingIPAddr string) (ip string, err error) {
+	if len(startingIPAddr) == 0 {
+		ip, err = server.ExternalIP()
+		if err != nil {
+			return "", err
+		}
+		return ip, nil
+	}
+	return calculateIP(startingIPAddr, i)
+}
+
+func calculateIP(ip string, i int) (string, error) {
+	ipv4 := net.ParseIP(ip).To4()
+	if ipv4 == nil {
+		return "", fmt.Errorf("%v: non ipv4 address", ip)
+	}
+
+	if int(ipv4[3])+i > 255 {
+		return "", fmt.Errorf("%v: no room for %d more addresses in the last octet", ip, i)
+	}
+	ipv4[3] += byte(i)
+
+	return ipv4.String(), nil
+}
+
+func writeFile(name string, dir string, contents []byte) error {
+	file := filepath.Join(dir, name)
+
+	err := cmn.EnsureDir(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return cmn.WriteFile(file, contents, 0600)
+}
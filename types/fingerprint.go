@@ -0,0 +1,15 @@
+package types
+
+import (
+	"strings"
+)
+
+// MaxFingerprintLength bounds the length of content fingerprints, e.g. IPFS
+// hashes, used by the iscn and reward modules
+const MaxFingerprintLength = 128
+
+// ValidateFingerprint checks the length of a content fingerprint. '/' is not
+// allowed, so that a fingerprint can be used as a query path segment.
+func ValidateFingerprint(fingerprint string) bool {
+	return len(fingerprint) > 0 && len(fingerprint) <= MaxFingerprintLength && !strings.Contains(fingerprint, "/")
+}
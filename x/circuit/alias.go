@@ -0,0 +1,30 @@
+package circuit
+
+import (
+	"github.com/likecoin/likechain/x/circuit/types"
+)
+
+const (
+	ModuleName       = types.ModuleName
+	QuerierRoute     = types.QuerierRoute
+	QueryParams      = types.QueryParams
+	UnpausableRoute  = types.UnpausableRoute
+	DefaultCodespace = types.DefaultCodespace
+	CodeTxTypePaused = types.CodeTxTypePaused
+)
+
+var (
+	ModuleCdc           = types.ModuleCdc
+	ErrTxTypePaused     = types.ErrTxTypePaused
+	DefaultGenesisState = types.DefaultGenesisState
+	DefaultParams       = types.DefaultParams
+	ValidateGenesis     = types.ValidateGenesis
+	ValidateParams      = types.ValidateParams
+	KeyPausedTxTypes    = types.KeyPausedTxTypes
+	RegisterCodec       = types.RegisterCodec
+)
+
+type (
+	GenesisState = types.GenesisState
+	Params       = types.Params
+)
@@ -0,0 +1,18 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAnteHandler wraps the given ante handler, rejecting transactions
+// containing any paused message type before they reach it
+func NewAnteHandler(keeper Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		for _, msg := range tx.GetMsgs() {
+			if keeper.IsTxTypePaused(ctx, msg) {
+				return ctx, ErrTxTypePaused(keeper.Codespace(), msg.Route(), msg.Type()).Result(), true
+			}
+		}
+		return next(ctx, tx, simulate)
+	}
+}
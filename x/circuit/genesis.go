@@ -0,0 +1,18 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, genesisState.Params)
+	return nil
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	return GenesisState{
+		Params: params,
+	}
+}
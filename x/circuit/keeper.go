@@ -0,0 +1,60 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	DefaultParamspace = ModuleName
+)
+
+type Keeper struct {
+	paramstore params.Subspace
+	codespace  sdk.CodespaceType
+}
+
+func NewKeeper(paramstore params.Subspace, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		paramstore: paramstore.WithKeyTable(ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
+}
+
+// IsTxTypePaused returns whether the message is paused, either by its route
+// or by its route and type. Messages routed to governance are never paused.
+func (keeper Keeper) IsTxTypePaused(ctx sdk.Context, msg sdk.Msg) bool {
+	if msg.Route() == UnpausableRoute {
+		return false
+	}
+	txType := msg.Route() + "/" + msg.Type()
+	for _, paused := range keeper.PausedTxTypes(ctx) {
+		if paused == msg.Route() || paused == txType {
+			return true
+		}
+	}
+	return false
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (k Keeper) PausedTxTypes(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, KeyPausedTxTypes, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return Params{
+		PausedTxTypes: k.PausedTxTypes(ctx),
+	}
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
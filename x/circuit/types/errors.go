@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeTxTypePaused sdk.CodeType = 101
+)
+
+func ErrTxTypePaused(codespace sdk.CodespaceType, route string, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeTxTypePaused, "transaction type is paused: %s/%s", route, msgType)
+}
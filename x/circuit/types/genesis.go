@@ -0,0 +1,15 @@
+package types
+
+type GenesisState struct {
+	Params Params `json:"params" yaml:"params"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	return ValidateParams(data.Params)
+}
@@ -0,0 +1,6 @@
+package types
+
+const (
+	ModuleName   = "circuit"
+	QuerierRoute = ModuleName
+)
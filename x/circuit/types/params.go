@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// UnpausableRoute is the message route which can never be paused, so that
+// paused transaction types can always be resumed by governance
+const UnpausableRoute = "gov"
+
+type Params struct {
+	// entries are either a message route (e.g. "stream") which pauses every
+	// message of the module, or a route and type (e.g. "bank/send")
+	PausedTxTypes []string `json:"paused_tx_types" yaml:"paused_tx_types"`
+}
+
+var (
+	KeyPausedTxTypes = []byte("PausedTxTypes")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyPausedTxTypes, Value: &p.PausedTxTypes},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{
+		PausedTxTypes: []string{},
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Paused Transaction Types: %s`, strings.Join(p.PausedTxTypes, ", "))
+}
+
+func ValidateParams(p Params) error {
+	seen := make(map[string]bool)
+	for _, txType := range p.PausedTxTypes {
+		parts := strings.Split(txType, "/")
+		if len(parts) > 2 || parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+			return fmt.Errorf("invalid paused transaction type: %s", txType)
+		}
+		if parts[0] == UnpausableRoute {
+			return fmt.Errorf("transaction type cannot be paused: %s", txType)
+		}
+		if seen[txType] {
+			return fmt.Errorf("duplicated paused transaction type: %s", txType)
+		}
+		seen[txType] = true
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package iscn
+
+import (
+	"github.com/likecoin/likechain/x/iscn/types"
+)
+
+const (
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	QuerierRoute              = types.QuerierRoute
+	RouterKey                 = types.RouterKey
+	QueryContentInfo          = types.QueryContentInfo
+	QueryContentByOwner       = types.QueryContentByOwner
+	MaxContentRecordsPerQuery = types.MaxContentRecordsPerQuery
+)
+
+var (
+	ModuleCdc                = types.ModuleCdc
+	NewMsgRegisterContent    = types.NewMsgRegisterContent
+	ErrInvalidFingerprint    = types.ErrInvalidFingerprint
+	ErrContentExists         = types.ErrContentExists
+	ErrContentNotFound       = types.ErrContentNotFound
+	DefaultGenesisState      = types.DefaultGenesisState
+	DefaultCodespace         = types.DefaultCodespace
+	ValidateGenesis          = types.ValidateGenesis
+	ContentRecordKey         = types.ContentRecordKey
+	OwnerContentKey          = types.OwnerContentKey
+	GetContentRecordKey      = types.GetContentRecordKey
+	GetOwnerContentPrefix    = types.GetOwnerContentPrefix
+	GetOwnerContentKey       = types.GetOwnerContentKey
+	EventTypeRegisterContent = types.EventTypeRegisterContent
+	AttributeKeyFingerprint  = types.AttributeKeyFingerprint
+	AttributeKeyOwner        = types.AttributeKeyOwner
+	AttributeKeyParent       = types.AttributeKeyParent
+	AttributeValueCategory   = types.AttributeValueCategory
+	RegisterCodec            = types.RegisterCodec
+)
+
+type (
+	MsgRegisterContent = types.MsgRegisterContent
+	ContentRecords     = types.ContentRecords
+	ContentRecord      = types.ContentRecord
+	GenesisState       = types.GenesisState
+)
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/likecoin/likechain/x/iscn/types"
+)
+
+const flagAfter = "after"
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	iscnQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the iscn module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	iscnQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryContentInfo(queryRoute, cdc),
+		GetCmdQueryContentByOwner(queryRoute, cdc),
+	)...)
+
+	return iscnQueryCmd
+}
+
+// GetCmdQueryContentInfo implements the content info query command.
+func GetCmdQueryContentInfo(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "content [fingerprint]",
+		Short: "Query a registered content by its fingerprint",
+		Long: strings.TrimSpace(`Query a registered content by its fingerprint:
+
+$ likecli query iscn content QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryContentInfo, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var record types.ContentRecord
+			cdc.MustUnmarshalJSON(res, &record)
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
+
+// GetCmdQueryContentByOwner implements the content by owner query command.
+func GetCmdQueryContentByOwner(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "content-by-owner [owner]",
+		Short: "Query the contents registered by an owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the contents registered by an owner, at most %d per query in
+fingerprint order. Pass the last fingerprint of a page to --after to get the
+next page:
+
+$ likecli query iscn content-by-owner cosmos1...
+$ likecli query iscn content-by-owner cosmos1... --after QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco
+`, types.MaxContentRecordsPerQuery)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryContentByOwner, args[0])
+			if after := viper.GetString(flagAfter); after != "" {
+				route = fmt.Sprintf("%s/%s", route, after)
+			}
+			res, _, err := cliCtx.Query(route)
+			if err != nil {
+				return err
+			}
+
+			records := types.ContentRecords{}
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+	cmd.Flags().String(flagAfter, "", "fingerprint of the last content in the previous page")
+	return cmd
+}
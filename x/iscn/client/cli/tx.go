@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/likecoin/likechain/x/iscn/types"
+)
+
+const flagParent = "parent"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	iscnTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "ISCN transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	iscnTxCmd.AddCommand(client.PostCommands(
+		GetCmdRegisterContent(cdc),
+	)...)
+
+	return iscnTxCmd
+}
+
+// GetCmdRegisterContent implements the register content command
+func GetCmdRegisterContent(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [fingerprint]",
+		Short: "register a content by its fingerprint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgRegisterContent(cliCtx.GetFromAddress(), args[0], viper.GetString(flagParent))
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagParent, "", "fingerprint of the previous version or the original content")
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/likecoin/likechain/x/iscn/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/iscn/contents/{fingerprint}",
+		contentInfoHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/iscn/owners/{owner}/contents",
+		contentByOwnerHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func contentInfoHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		fingerprint := mux.Vars(r)["fingerprint"]
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.ModuleName, types.QueryContentInfo, fingerprint))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func contentByOwnerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		owner := mux.Vars(r)["owner"]
+		route := fmt.Sprintf("custom/%s/%s/%s", types.ModuleName, types.QueryContentByOwner, owner)
+		// ?after=<fingerprint> returns the page after the given fingerprint
+		if after := r.URL.Query().Get("after"); after != "" {
+			route = fmt.Sprintf("%s/%s", route, after)
+		}
+		res, height, err := cliCtx.Query(route)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
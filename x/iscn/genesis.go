@@ -0,0 +1,20 @@
+package iscn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
+	for _, record := range genesisState.Records {
+		keeper.SetContentRecord(ctx, record)
+	}
+	return nil
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	records := keeper.GetAllContentRecords(ctx)
+	return GenesisState{
+		Records: records,
+	}
+}
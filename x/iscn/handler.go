@@ -0,0 +1,57 @@
+package iscn
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgRegisterContent:
+			return handleMsgRegisterContent(ctx, msg, keeper)
+		default:
+			errMsg := fmt.Sprintf("unrecognized iscn message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgRegisterContent(ctx sdk.Context, msg MsgRegisterContent, keeper Keeper) sdk.Result {
+	_, found := keeper.GetContentRecord(ctx, msg.Fingerprint)
+	if found {
+		return ErrContentExists(keeper.Codespace(), msg.Fingerprint).Result()
+	}
+	if msg.Parent != "" {
+		_, found := keeper.GetContentRecord(ctx, msg.Parent)
+		if !found {
+			return ErrContentNotFound(keeper.Codespace(), msg.Parent).Result()
+		}
+	}
+	record := ContentRecord{
+		Fingerprint: msg.Fingerprint,
+		Owner:       msg.Owner,
+		Timestamp:   ctx.BlockHeader().Time,
+		Height:      ctx.BlockHeight(),
+		Parent:      msg.Parent,
+	}
+	keeper.SetContentRecord(ctx, record)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeRegisterContent,
+			sdk.NewAttribute(AttributeKeyFingerprint, record.Fingerprint),
+			sdk.NewAttribute(AttributeKeyOwner, record.Owner.String()),
+			sdk.NewAttribute(AttributeKeyParent, record.Parent),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,42 @@
+package iscn
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all ISCN invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(ModuleName, "owner-index", OwnerIndexInvariant(keeper))
+}
+
+// OwnerIndexInvariant checks that the owner index has exactly one entry for
+// each content record
+func OwnerIndexInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(keeper.storeKey)
+		var records, missing int
+
+		keeper.IterateContentRecords(ctx, func(record ContentRecord) bool {
+			records++
+			if !store.Has(GetOwnerContentKey(record.Owner, record.Fingerprint)) {
+				missing++
+			}
+			return false
+		})
+
+		var indexed int
+		iter := sdk.KVStorePrefixIterator(store, OwnerContentKey)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			indexed++
+		}
+
+		broken := missing != 0 || indexed != records
+
+		return sdk.FormatInvariant(ModuleName, "owner index",
+			fmt.Sprintf("\tcontent records: %d\n\towner index entries: %d\n\trecords missing from index: %d\n",
+				records, indexed, missing)), broken
+	}
+}
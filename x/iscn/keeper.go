@@ -0,0 +1,81 @@
+package iscn
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       *codec.Codec
+	codespace sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		codespace: codespace,
+	}
+}
+
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
+}
+
+func (keeper Keeper) GetContentRecord(ctx sdk.Context, fingerprint string) (record ContentRecord, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(GetContentRecordKey(fingerprint))
+	if bz == nil {
+		return record, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// SetContentRecord stores the record and indexes it under its owner
+func (keeper Keeper) SetContentRecord(ctx sdk.Context, record ContentRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(GetContentRecordKey(record.Fingerprint), bz)
+	store.Set(GetOwnerContentKey(record.Owner, record.Fingerprint), []byte(record.Fingerprint))
+}
+
+func (keeper Keeper) IterateContentRecords(ctx sdk.Context, cb func(record ContentRecord) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), ContentRecordKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record ContentRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) GetAllContentRecords(ctx sdk.Context) (records []ContentRecord) {
+	keeper.IterateContentRecords(ctx, func(record ContentRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetContentRecordsByOwner returns at most limit records of the owner in
+// fingerprint order, starting after the given fingerprint if it is not empty
+func (keeper Keeper) GetContentRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress, after string, limit int) (records []ContentRecord) {
+	prefix := GetOwnerContentPrefix(owner)
+	start := prefix
+	if after != "" {
+		start = append(GetOwnerContentKey(owner, after), 0x00)
+	}
+	iter := ctx.KVStore(keeper.storeKey).Iterator(start, sdk.PrefixEndBytes(prefix))
+	defer iter.Close()
+	for ; iter.Valid() && len(records) < limit; iter.Next() {
+		record, found := keeper.GetContentRecord(ctx, string(iter.Value()))
+		if !found {
+			panic("content in owner index not found")
+		}
+		records = append(records, record)
+	}
+	return records
+}
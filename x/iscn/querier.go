@@ -0,0 +1,64 @@
+package iscn
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryContentInfo:
+			return queryContentInfo(ctx, path[1:], req, k)
+		case QueryContentByOwner:
+			return queryContentByOwner(ctx, path[1:], req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown iscn query endpoint")
+		}
+	}
+}
+
+func queryContentInfo(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing fingerprint")
+	}
+	record, found := k.GetContentRecord(ctx, path[0])
+	if !found {
+		return nil, ErrContentNotFound(k.Codespace(), path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, record)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryContentByOwner(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing owner address")
+	}
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(err.Error())
+	}
+	// the optional second segment is the last fingerprint of the previous page
+	after := ""
+	if len(path) > 1 {
+		after = path[1]
+	}
+	records := k.GetContentRecordsByOwner(ctx, owner, after, MaxContentRecordsPerQuery)
+	if records == nil {
+		records = []ContentRecord{}
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContentRecord is the on-chain attribution record of a content, identified
+// by its fingerprint (e.g. IPFS hash)
+type ContentRecord struct {
+	Fingerprint string         `json:"fingerprint" yaml:"fingerprint"`
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Timestamp   time.Time      `json:"timestamp" yaml:"timestamp"`
+	Height      int64          `json:"height" yaml:"height"`
+	// fingerprint of the previous version or the original content, empty if none
+	Parent string `json:"parent" yaml:"parent"`
+}
+
+func (record ContentRecord) String() string {
+	return fmt.Sprintf(`ContentRecord:
+  Fingerprint: %s
+  Owner:       %s
+  Timestamp:   %s
+  Height:      %d
+  Parent:      %s`, record.Fingerprint, record.Owner, record.Timestamp, record.Height, record.Parent)
+}
+
+type ContentRecords []ContentRecord
+
+func (records ContentRecords) String() string {
+	out := ""
+	for _, record := range records {
+		out += record.String() + "\n"
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidFingerprint sdk.CodeType = 101
+	CodeContentExists      sdk.CodeType = 102
+	CodeContentNotFound    sdk.CodeType = 103
+)
+
+func ErrInvalidFingerprint(codespace sdk.CodespaceType, fingerprint string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFingerprint, "invalid content fingerprint: %s", fingerprint)
+}
+
+func ErrContentExists(codespace sdk.CodespaceType, fingerprint string) sdk.Error {
+	return sdk.NewError(codespace, CodeContentExists, "content already registered: %s", fingerprint)
+}
+
+func ErrContentNotFound(codespace sdk.CodespaceType, fingerprint string) sdk.Error {
+	return sdk.NewError(codespace, CodeContentNotFound, "content not found: %s", fingerprint)
+}
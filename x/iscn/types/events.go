@@ -0,0 +1,10 @@
+package types
+
+var (
+	EventTypeRegisterContent = "register_content"
+
+	AttributeKeyFingerprint = "fingerprint"
+	AttributeKeyOwner       = "owner"
+	AttributeKeyParent      = "parent"
+	AttributeValueCategory  = ModuleName
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	liketypes "github.com/likecoin/likechain/types"
+)
+
+type GenesisState struct {
+	Records []ContentRecord `json:"records" yaml:"records"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	fingerprints := make(map[string]bool)
+	for _, record := range data.Records {
+		if !liketypes.ValidateFingerprint(record.Fingerprint) {
+			return fmt.Errorf("invalid fingerprint in genesis: %s", record.Fingerprint)
+		}
+		if fingerprints[record.Fingerprint] {
+			return fmt.Errorf("duplicated fingerprint in genesis: %s", record.Fingerprint)
+		}
+		if record.Owner.Empty() {
+			return fmt.Errorf("empty owner for content in genesis: %s", record.Fingerprint)
+		}
+		fingerprints[record.Fingerprint] = true
+	}
+	for _, record := range data.Records {
+		if record.Parent != "" && !fingerprints[record.Parent] {
+			return fmt.Errorf("parent of content %s not found in genesis: %s", record.Fingerprint, record.Parent)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "iscn"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+var (
+	ContentRecordKey = []byte{0x11}
+	OwnerContentKey  = []byte{0x12}
+)
+
+func GetContentRecordKey(fingerprint string) []byte {
+	return append(ContentRecordKey, []byte(fingerprint)...)
+}
+
+func GetOwnerContentPrefix(owner sdk.AccAddress) []byte {
+	return append(OwnerContentKey, owner.Bytes()...)
+}
+
+// owner index entries are keyed by owner address followed by content fingerprint
+func GetOwnerContentKey(owner sdk.AccAddress, fingerprint string) []byte {
+	return append(GetOwnerContentPrefix(owner), []byte(fingerprint)...)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	liketypes "github.com/likecoin/likechain/types"
+)
+
+var _ sdk.Msg = &MsgRegisterContent{}
+
+type MsgRegisterContent struct {
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Fingerprint string         `json:"fingerprint" yaml:"fingerprint"`
+	Parent      string         `json:"parent" yaml:"parent"`
+}
+
+func NewMsgRegisterContent(owner sdk.AccAddress, fingerprint string, parent string) MsgRegisterContent {
+	return MsgRegisterContent{
+		Owner:       owner,
+		Fingerprint: fingerprint,
+		Parent:      parent,
+	}
+}
+
+func (msg MsgRegisterContent) Route() string { return RouterKey }
+func (msg MsgRegisterContent) Type() string  { return "register_content" }
+
+func (msg MsgRegisterContent) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgRegisterContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRegisterContent) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if !liketypes.ValidateFingerprint(msg.Fingerprint) {
+		return ErrInvalidFingerprint(DefaultCodespace, msg.Fingerprint)
+	}
+	if msg.Parent != "" && (!liketypes.ValidateFingerprint(msg.Parent) || msg.Parent == msg.Fingerprint) {
+		return ErrInvalidFingerprint(DefaultCodespace, msg.Parent)
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package types
+
+const (
+	QueryContentInfo    = "content_info"
+	QueryContentByOwner = "content_by_owner"
+
+	// MaxContentRecordsPerQuery bounds the records returned by one
+	// content_by_owner query
+	MaxContentRecordsPerQuery = 100
+)
@@ -0,0 +1,56 @@
+package nameservice
+
+import (
+	"github.com/likecoin/likechain/x/nameservice/types"
+)
+
+const (
+	ModuleName           = types.ModuleName
+	StoreKey             = types.StoreKey
+	QuerierRoute         = types.QuerierRoute
+	RouterKey            = types.RouterKey
+	QueryResolveName     = types.QueryResolveName
+	QueryResolveIdentity = types.QueryResolveIdentity
+)
+
+var (
+	ModuleCdc              = types.ModuleCdc
+	NewMsgRegisterName     = types.NewMsgRegisterName
+	NewMsgTransferName     = types.NewMsgTransferName
+	NormalizeName          = types.NormalizeName
+	ValidateName           = types.ValidateName
+	ErrInvalidName         = types.ErrInvalidName
+	ErrNameTaken           = types.ErrNameTaken
+	ErrNameNotFound        = types.ErrNameNotFound
+	ErrNotNameOwner        = types.ErrNotNameOwner
+	KeyRegistrationPeriod  = types.KeyRegistrationPeriod
+	KeyRegistrationFee     = types.KeyRegistrationFee
+	DefaultParams          = types.DefaultParams
+	DefaultRegistrationFee = types.DefaultRegistrationFee
+	DefaultGenesisState    = types.DefaultGenesisState
+	DefaultCodespace       = types.DefaultCodespace
+	ValidateGenesis        = types.ValidateGenesis
+	NameRecordKey          = types.NameRecordKey
+	GetNameRecordKey       = types.GetNameRecordKey
+	OwnerNameKey           = types.OwnerNameKey
+	GetOwnerNamePrefix     = types.GetOwnerNamePrefix
+	GetOwnerNameKey        = types.GetOwnerNameKey
+	EventTypeRegisterName  = types.EventTypeRegisterName
+	EventTypeTransferName  = types.EventTypeTransferName
+	AttributeKeyName       = types.AttributeKeyName
+	AttributeKeyOwner      = types.AttributeKeyOwner
+	AttributeKeyNewOwner   = types.AttributeKeyNewOwner
+	AttributeKeyExpiry     = types.AttributeKeyExpiry
+	AttributeValueCategory = types.AttributeValueCategory
+	RegisterCodec          = types.RegisterCodec
+)
+
+type (
+	MsgRegisterName = types.MsgRegisterName
+	MsgTransferName = types.MsgTransferName
+	NameRecord      = types.NameRecord
+	Identity        = types.Identity
+	Params          = types.Params
+	GenesisState    = types.GenesisState
+	SupplyKeeper    = types.SupplyKeeper
+)
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/likecoin/likechain/x/nameservice/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	nameserviceQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nameservice module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	nameserviceQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryResolveName(queryRoute, cdc),
+		GetCmdQueryResolveIdentity(queryRoute, cdc),
+	)...)
+
+	return nameserviceQueryCmd
+}
+
+// GetCmdQueryResolveName implements the resolve name query command.
+func GetCmdQueryResolveName(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resolve [name]",
+		Short: "Resolve a registered name to its owner",
+		Long: strings.TrimSpace(`Resolve a registered name to its owner:
+
+$ likecli query nameservice resolve @alice
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name := types.NormalizeName(args[0])
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryResolveName, name))
+			if err != nil {
+				return err
+			}
+
+			var record types.NameRecord
+			cdc.MustUnmarshalJSON(res, &record)
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
+
+// GetCmdQueryResolveIdentity implements the resolve identity query command.
+func GetCmdQueryResolveIdentity(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resolve-identity [address-or-name]",
+		Short: "Resolve an address or a registered name to the address and all its names",
+		Long: strings.TrimSpace(`Resolve an address or a registered name to the address and all its names:
+
+$ likecli query nameservice resolve-identity @alice
+$ likecli query nameservice resolve-identity cosmos1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryResolveIdentity, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var identity types.Identity
+			cdc.MustUnmarshalJSON(res, &identity)
+			return cliCtx.PrintOutput(identity)
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/likecoin/likechain/x/nameservice/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	nameserviceTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Nameservice transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nameserviceTxCmd.AddCommand(client.PostCommands(
+		GetCmdRegisterName(cdc),
+		GetCmdTransferName(cdc),
+	)...)
+
+	return nameserviceTxCmd
+}
+
+// GetCmdRegisterName implements the register name command
+func GetCmdRegisterName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [name]",
+		Short: "register a name, or renew a name owned by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name := types.NormalizeName(args[0])
+			msg := types.NewMsgRegisterName(cliCtx.GetFromAddress(), name)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// GetCmdTransferName implements the transfer name command
+func GetCmdTransferName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [name] [new_owner]",
+		Short: "transfer a name to another address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name := types.NormalizeName(args[0])
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferName(cliCtx.GetFromAddress(), name, newOwner)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/likecoin/likechain/x/nameservice/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/nameservice/names/{name}",
+		resolveNameHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/nameservice/identities/{identifier}",
+		resolveIdentityHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func resolveNameHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		name := types.NormalizeName(mux.Vars(r)["name"])
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.ModuleName, types.QueryResolveName, name))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func resolveIdentityHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		identifier := mux.Vars(r)["identifier"]
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", types.ModuleName, types.QueryResolveIdentity, identifier))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,23 @@
+package nameservice
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, genesisState.Params)
+	for _, record := range genesisState.Records {
+		keeper.SetNameRecord(ctx, record)
+	}
+	return nil
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	records := keeper.GetAllNameRecords(ctx)
+	return GenesisState{
+		Params:  params,
+		Records: records,
+	}
+}
@@ -0,0 +1,91 @@
+package nameservice
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgRegisterName:
+			return handleMsgRegisterName(ctx, msg, keeper)
+		case MsgTransferName:
+			return handleMsgTransferName(ctx, msg, keeper)
+		default:
+			errMsg := fmt.Sprintf("unrecognized nameservice message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgRegisterName(ctx sdk.Context, msg MsgRegisterName, keeper Keeper) sdk.Result {
+	// registering a name already owned by the sender renews it
+	record, found := keeper.ResolveName(ctx, msg.Name)
+	if found && !record.Owner.Equals(msg.Owner) {
+		return ErrNameTaken(keeper.Codespace(), msg.Name).Result()
+	}
+	fee, err := keeper.BurnRegistrationFee(ctx, msg.Owner)
+	if err != nil {
+		return err.Result()
+	}
+	expiry := int64(0)
+	period := keeper.RegistrationPeriod(ctx)
+	if period > 0 {
+		expiry = ctx.BlockHeight() + period
+	}
+	record = NameRecord{
+		Name:   msg.Name,
+		Owner:  msg.Owner,
+		Expiry: expiry,
+	}
+	keeper.SetNameRecord(ctx, record)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeRegisterName,
+			sdk.NewAttribute(AttributeKeyName, record.Name),
+			sdk.NewAttribute(AttributeKeyOwner, record.Owner.String()),
+			sdk.NewAttribute(AttributeKeyExpiry, strconv.FormatInt(record.Expiry, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgTransferName(ctx sdk.Context, msg MsgTransferName, keeper Keeper) sdk.Result {
+	record, found := keeper.ResolveName(ctx, msg.Name)
+	if !found {
+		return ErrNameNotFound(keeper.Codespace(), msg.Name).Result()
+	}
+	if !record.Owner.Equals(msg.Owner) {
+		return ErrNotNameOwner(keeper.Codespace(), msg.Name).Result()
+	}
+	record.Owner = msg.NewOwner
+	keeper.SetNameRecord(ctx, record)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeTransferName,
+			sdk.NewAttribute(AttributeKeyName, record.Name),
+			sdk.NewAttribute(AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(AttributeKeyNewOwner, msg.NewOwner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,149 @@
+package nameservice
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	DefaultParamspace = ModuleName
+)
+
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramstore   params.Subspace
+	supplyKeeper SupplyKeeper
+	codespace    sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, supplyKeeper SupplyKeeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		paramstore:   paramstore.WithKeyTable(ParamKeyTable()),
+		supplyKeeper: supplyKeeper,
+		codespace:    codespace,
+	}
+}
+
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
+}
+
+func (keeper Keeper) GetNameRecord(ctx sdk.Context, name string) (record NameRecord, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(GetNameRecordKey(name))
+	if bz == nil {
+		return record, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// SetNameRecord stores the record and moves it in the owner index if the
+// owner has changed
+func (keeper Keeper) SetNameRecord(ctx sdk.Context, record NameRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	old, found := keeper.GetNameRecord(ctx, record.Name)
+	if found {
+		store.Delete(GetOwnerNameKey(old.Owner, old.Name))
+	}
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(GetNameRecordKey(record.Name), bz)
+	store.Set(GetOwnerNameKey(record.Owner, record.Name), []byte{})
+}
+
+// DeleteNameRecord removes the record and its owner index entry
+func (keeper Keeper) DeleteNameRecord(ctx sdk.Context, record NameRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(GetNameRecordKey(record.Name))
+	store.Delete(GetOwnerNameKey(record.Owner, record.Name))
+}
+
+// BurnRegistrationFee takes the registration fee from the owner and burns it
+func (keeper Keeper) BurnRegistrationFee(ctx sdk.Context, owner sdk.AccAddress) (fee sdk.Coins, err sdk.Error) {
+	fee = keeper.RegistrationFee(ctx)
+	if fee.Empty() {
+		return fee, nil
+	}
+	err = keeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, ModuleName, fee)
+	if err != nil {
+		return nil, err
+	}
+	err = keeper.supplyKeeper.BurnCoins(ctx, ModuleName, fee)
+	if err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+// ResolveName returns the record of the name if it is registered and not
+// expired at the current block height
+func (keeper Keeper) ResolveName(ctx sdk.Context, name string) (record NameRecord, found bool) {
+	record, found = keeper.GetNameRecord(ctx, name)
+	if !found || record.IsExpired(ctx.BlockHeight()) {
+		return NameRecord{}, false
+	}
+	return record, true
+}
+
+// GetNamesByOwner returns the unexpired names owned by the address, in
+// lexicographical order
+func (keeper Keeper) GetNamesByOwner(ctx sdk.Context, owner sdk.AccAddress) (names []string) {
+	prefix := GetOwnerNamePrefix(owner)
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		name := string(iter.Key()[len(prefix):])
+		if _, found := keeper.ResolveName(ctx, name); found {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (keeper Keeper) IterateNameRecords(ctx sdk.Context, cb func(record NameRecord) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), NameRecordKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record NameRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) GetAllNameRecords(ctx sdk.Context) (records []NameRecord) {
+	keeper.IterateNameRecords(ctx, func(record NameRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (k Keeper) RegistrationPeriod(ctx sdk.Context) (res int64) {
+	k.paramstore.Get(ctx, KeyRegistrationPeriod, &res)
+	return
+}
+
+func (k Keeper) RegistrationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, KeyRegistrationFee, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return Params{
+		RegistrationPeriod: k.RegistrationPeriod(ctx),
+		RegistrationFee:    k.RegistrationFee(ctx),
+	}
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
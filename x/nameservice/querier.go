@@ -0,0 +1,70 @@
+package nameservice
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryResolveName:
+			return queryResolveName(ctx, path[1:], req, k)
+		case QueryResolveIdentity:
+			return queryResolveIdentity(ctx, path[1:], req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown nameservice query endpoint")
+		}
+	}
+}
+
+func queryResolveName(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing name")
+	}
+	name := NormalizeName(path[0])
+	record, found := k.ResolveName(ctx, name)
+	if !found {
+		return nil, ErrNameNotFound(k.Codespace(), name)
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, record)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// queryResolveIdentity accepts either a bech32 address or a registered name,
+// and returns the address with all the names it owns
+func queryResolveIdentity(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing address or name")
+	}
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		name := NormalizeName(path[0])
+		record, found := k.ResolveName(ctx, name)
+		if !found {
+			return nil, ErrNameNotFound(k.Codespace(), name)
+		}
+		address = record.Owner
+	}
+	identity := Identity{
+		Address: address,
+		Names:   k.GetNamesByOwner(ctx, address),
+	}
+	if identity.Names == nil {
+		identity.Names = []string{}
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, identity)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidName  sdk.CodeType = 101
+	CodeNameTaken    sdk.CodeType = 102
+	CodeNameNotFound sdk.CodeType = 103
+	CodeNotNameOwner sdk.CodeType = 104
+)
+
+func ErrInvalidName(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidName, "invalid name: %s", name)
+}
+
+func ErrNameTaken(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeNameTaken, "name already registered: %s", name)
+}
+
+func ErrNameNotFound(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeNameNotFound, "name not found: %s", name)
+}
+
+func ErrNotNameOwner(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotNameOwner, "sender is not the owner of name: %s", name)
+}
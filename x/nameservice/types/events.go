@@ -0,0 +1,12 @@
+package types
+
+var (
+	EventTypeRegisterName = "register_name"
+	EventTypeTransferName = "transfer_name"
+
+	AttributeKeyName       = "name"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyNewOwner   = "new_owner"
+	AttributeKeyExpiry     = "expiry"
+	AttributeValueCategory = ModuleName
+)
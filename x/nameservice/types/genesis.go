@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+type GenesisState struct {
+	Records []NameRecord `json:"records" yaml:"records"`
+	Params  Params       `json:"params" yaml:"params"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if data.Params.RegistrationPeriod < 0 {
+		return fmt.Errorf("negative registration period: %d", data.Params.RegistrationPeriod)
+	}
+	if !data.Params.RegistrationFee.IsValid() {
+		return fmt.Errorf("invalid registration fee: %s", data.Params.RegistrationFee)
+	}
+	names := make(map[string]bool)
+	for _, record := range data.Records {
+		if !ValidateName(record.Name) {
+			return fmt.Errorf("invalid name in genesis: %s", record.Name)
+		}
+		if names[record.Name] {
+			return fmt.Errorf("duplicated name in genesis: %s", record.Name)
+		}
+		if record.Owner.Empty() {
+			return fmt.Errorf("empty owner for name in genesis: %s", record.Name)
+		}
+		names[record.Name] = true
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "nameservice"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+var (
+	NameRecordKey = []byte{0x11}
+	OwnerNameKey  = []byte{0x12}
+)
+
+func GetNameRecordKey(name string) []byte {
+	return append(NameRecordKey, []byte(name)...)
+}
+
+func GetOwnerNamePrefix(owner sdk.AccAddress) []byte {
+	return append(OwnerNameKey, owner.Bytes()...)
+}
+
+// owner index entries are keyed by owner address followed by name
+func GetOwnerNameKey(owner sdk.AccAddress, name string) []byte {
+	return append(GetOwnerNamePrefix(owner), []byte(name)...)
+}
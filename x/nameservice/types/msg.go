@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRegisterName{}
+var _ sdk.Msg = &MsgTransferName{}
+
+// MsgRegisterName registers a new name, or renews a name already owned by the
+// sender.
+type MsgRegisterName struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name  string         `json:"name" yaml:"name"`
+}
+
+func NewMsgRegisterName(owner sdk.AccAddress, name string) MsgRegisterName {
+	return MsgRegisterName{
+		Owner: owner,
+		Name:  name,
+	}
+}
+
+func (msg MsgRegisterName) Route() string { return RouterKey }
+func (msg MsgRegisterName) Type() string  { return "register_name" }
+
+func (msg MsgRegisterName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgRegisterName) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRegisterName) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if !ValidateName(msg.Name) {
+		return ErrInvalidName(DefaultCodespace, msg.Name)
+	}
+	return nil
+}
+
+// MsgTransferName transfers the ownership of a registered name to another
+// address, keeping its expiry unchanged.
+type MsgTransferName struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name     string         `json:"name" yaml:"name"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+func NewMsgTransferName(owner sdk.AccAddress, name string, newOwner sdk.AccAddress) MsgTransferName {
+	return MsgTransferName{
+		Owner:    owner,
+		Name:     name,
+		NewOwner: newOwner,
+	}
+}
+
+func (msg MsgTransferName) Route() string { return RouterKey }
+func (msg MsgTransferName) Type() string  { return "transfer_name" }
+
+func (msg MsgTransferName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgTransferName) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTransferName) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if msg.NewOwner.Empty() {
+		return sdk.ErrInvalidAddress("missing new owner address")
+	}
+	if !ValidateName(msg.Name) {
+		return ErrInvalidName(DefaultCodespace, msg.Name)
+	}
+	return nil
+}
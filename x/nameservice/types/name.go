@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// names are 3 to 32 characters of lowercase letters, digits, '-' and '_',
+// starting with a letter or digit
+var nameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{2,31}$`)
+
+// NormalizeName strips the optional '@' prefix and surrounding spaces, and
+// lowercases the name, so that "@Alice" and "alice" refer to the same record.
+func NormalizeName(name string) string {
+	name = strings.TrimSpace(name)
+	name = strings.TrimPrefix(name, "@")
+	return strings.ToLower(name)
+}
+
+// ValidateName checks that the name is in normalized form and has valid
+// characters and length.
+func ValidateName(name string) bool {
+	return NormalizeName(name) == name && nameRegexp.MatchString(name)
+}
+
+type NameRecord struct {
+	Name  string         `json:"name" yaml:"name"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	// block height at which the registration expires, 0 means never expire
+	Expiry int64 `json:"expiry" yaml:"expiry"`
+}
+
+func (record NameRecord) IsExpired(height int64) bool {
+	return record.Expiry != 0 && height >= record.Expiry
+}
+
+// Identity is an address together with the names it currently owns
+type Identity struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Names   []string       `json:"names" yaml:"names"`
+}
+
+func (identity Identity) String() string {
+	return fmt.Sprintf(`Identity:
+  Address: %s
+  Names:   %s`, identity.Address, strings.Join(identity.Names, ", "))
+}
+
+func (record NameRecord) String() string {
+	return fmt.Sprintf(`NameRecord:
+  Name:   %s
+  Owner:  %s
+  Expiry: %d`, record.Name, record.Owner, record.Expiry)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// DefaultRegistrationPeriod is about 1 year at 5 seconds per block
+	DefaultRegistrationPeriod int64 = 365 * 24 * 60 * 60 / 5
+)
+
+var (
+	DefaultRegistrationFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1)))
+)
+
+type Params struct {
+	// number of blocks a registration lasts, 0 means registrations never expire
+	RegistrationPeriod int64 `json:"registration_period" yaml:"registration_period"`
+	// fee burned on every registration or renewal
+	RegistrationFee sdk.Coins `json:"registration_fee" yaml:"registration_fee"`
+}
+
+var (
+	KeyRegistrationPeriod = []byte("RegistrationPeriod")
+	KeyRegistrationFee    = []byte("RegistrationFee")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyRegistrationPeriod, Value: &p.RegistrationPeriod},
+		{Key: KeyRegistrationFee, Value: &p.RegistrationFee},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{
+		RegistrationPeriod: DefaultRegistrationPeriod,
+		RegistrationFee:    DefaultRegistrationFee,
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Registration Period: %d
+  Registration Fee:    %s`, p.RegistrationPeriod, p.RegistrationFee)
+}
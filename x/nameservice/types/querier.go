@@ -0,0 +1,6 @@
+package types
+
+const (
+	QueryResolveName     = "resolve_name"
+	QueryResolveIdentity = "resolve_identity"
+)
@@ -0,0 +1,45 @@
+package reward
+
+import (
+	"github.com/likecoin/likechain/x/reward/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	QuerierRoute = types.QuerierRoute
+	RouterKey    = types.RouterKey
+	QueryOracle  = types.QueryOracle
+	QueryClaim   = types.QueryClaim
+)
+
+var (
+	ModuleCdc               = types.ModuleCdc
+	NewMsgClaimReward       = types.NewMsgClaimReward
+	ErrInvalidOracle        = types.ErrInvalidOracle
+	ErrInvalidFingerprint   = types.ErrInvalidFingerprint
+	ErrInvalidClaims        = types.ErrInvalidClaims
+	ErrRewardClaimed        = types.ErrRewardClaimed
+	ErrClaimNotFound        = types.ErrClaimNotFound
+	KeyOracle               = types.KeyOracle
+	DefaultParams           = types.DefaultParams
+	DefaultGenesisState     = types.DefaultGenesisState
+	DefaultCodespace        = types.DefaultCodespace
+	ValidateGenesis         = types.ValidateGenesis
+	ClaimRecordKey          = types.ClaimRecordKey
+	GetClaimRecordKey       = types.GetClaimRecordKey
+	EventTypeClaimReward    = types.EventTypeClaimReward
+	AttributeKeyFingerprint = types.AttributeKeyFingerprint
+	AttributeKeyCreator     = types.AttributeKeyCreator
+	AttributeValueCategory  = types.AttributeValueCategory
+	RegisterCodec           = types.RegisterCodec
+)
+
+type (
+	MsgClaimReward = types.MsgClaimReward
+	Claim          = types.Claim
+	ClaimRecord    = types.ClaimRecord
+	BankKeeper     = types.BankKeeper
+	Params         = types.Params
+	GenesisState   = types.GenesisState
+)
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likechain/x/reward/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	rewardQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the reward module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	rewardQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryOracle(queryRoute, cdc),
+		GetCmdQueryClaim(queryRoute, cdc),
+	)...)
+
+	return rewardQueryCmd
+}
+
+// GetCmdQueryOracle implements the reward oracle query command.
+func GetCmdQueryOracle(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle",
+		Short: "Query the reward oracle",
+		Long: strings.TrimSpace(`Query the reward oracle:
+
+$ likecli query reward oracle
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", storeName, types.QueryOracle))
+			if err != nil {
+				return err
+			}
+
+			oracle := sdk.AccAddress{}
+			if len(res) > 0 {
+				cdc.UnmarshalJSON(res, &oracle)
+			}
+
+			return cliCtx.PrintOutput(oracle)
+		},
+	}
+}
+
+// GetCmdQueryClaim implements the reward claim record query command.
+func GetCmdQueryClaim(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim [fingerprint] [creator]",
+		Short: "Query the reward claimed by a creator for a content",
+		Long: strings.TrimSpace(`Query the reward claimed by a creator for a content:
+
+$ likecli query reward claim QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco cosmos1...
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s/%s", storeName, types.QueryClaim, args[0], args[1]))
+			if err != nil {
+				return err
+			}
+
+			var record types.ClaimRecord
+			cdc.MustUnmarshalJSON(res, &record)
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/likecoin/likechain/x/reward/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	rewardTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Reward transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	rewardTxCmd.AddCommand(client.PostCommands(
+		GetCmdClaimReward(cdc),
+	)...)
+
+	return rewardTxCmd
+}
+
+// GetCmdClaimReward implements the claim reward command
+func GetCmdClaimReward(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [fingerprint] [creator=amount]...",
+		Short: "pay creator rewards for a content as the reward oracle",
+		Long: strings.TrimSpace(`Pay creator rewards for a content as the reward oracle:
+
+$ likecli tx reward claim QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco cosmos1...=1000nanolike cosmos1...=500nanolike --from oracle
+`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			claims := []types.Claim{}
+			for _, claimStr := range args[1:] {
+				parts := strings.SplitN(claimStr, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid claim format, expect creator=amount: %s", claimStr)
+				}
+				creator, err := sdk.AccAddressFromBech32(parts[0])
+				if err != nil {
+					return err
+				}
+				amount, err := sdk.ParseCoins(parts[1])
+				if err != nil {
+					return err
+				}
+				claims = append(claims, types.Claim{Creator: creator, Amount: amount})
+			}
+
+			msg := types.NewMsgClaimReward(cliCtx.GetFromAddress(), args[0], claims)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
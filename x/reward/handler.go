@@ -0,0 +1,65 @@
+package reward
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgClaimReward:
+			return handleMsgClaimReward(ctx, msg, keeper)
+		default:
+			errMsg := fmt.Sprintf("unrecognized reward message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgClaimReward(ctx sdk.Context, msg MsgClaimReward, keeper Keeper) sdk.Result {
+	oracle := keeper.Oracle(ctx)
+	if !oracle.Equals(msg.Oracle) {
+		return ErrInvalidOracle(keeper.Codespace()).Result()
+	}
+	// check all claims before paying any of them, so a claimed entry or a
+	// blacklisted creator rejects the whole message
+	for _, claim := range msg.Claims {
+		if keeper.bankKeeper.BlacklistedAddr(claim.Creator) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive rewards", claim.Creator)).Result()
+		}
+		_, found := keeper.GetClaimRecord(ctx, msg.Fingerprint, claim.Creator)
+		if found {
+			return ErrRewardClaimed(keeper.Codespace(), msg.Fingerprint, claim.Creator).Result()
+		}
+	}
+	events := sdk.Events{}
+	for _, claim := range msg.Claims {
+		err := keeper.bankKeeper.SendCoins(ctx, msg.Oracle, claim.Creator, claim.Amount)
+		if err != nil {
+			return err.Result()
+		}
+		keeper.SetClaimRecord(ctx, ClaimRecord{
+			Fingerprint: msg.Fingerprint,
+			Creator:     claim.Creator,
+			Amount:      claim.Amount,
+			Height:      ctx.BlockHeight(),
+		})
+		events = append(events, sdk.NewEvent(
+			EventTypeClaimReward,
+			sdk.NewAttribute(AttributeKeyFingerprint, msg.Fingerprint),
+			sdk.NewAttribute(AttributeKeyCreator, claim.Creator.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claim.Amount.String()),
+		))
+	}
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Oracle.String()),
+	))
+	ctx.EventManager().EmitEvents(events)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,86 @@
+package reward
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	DefaultParamspace = ModuleName
+)
+
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramstore params.Subspace
+	bankKeeper BankKeeper
+	codespace  sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, bankKeeper BankKeeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramstore: paramstore.WithKeyTable(ParamKeyTable()),
+		bankKeeper: bankKeeper,
+		codespace:  codespace,
+	}
+}
+
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
+}
+
+func (keeper Keeper) GetClaimRecord(ctx sdk.Context, fingerprint string, creator sdk.AccAddress) (record ClaimRecord, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(GetClaimRecordKey(fingerprint, creator))
+	if bz == nil {
+		return record, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+func (keeper Keeper) SetClaimRecord(ctx sdk.Context, record ClaimRecord) {
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(record)
+	ctx.KVStore(keeper.storeKey).Set(GetClaimRecordKey(record.Fingerprint, record.Creator), bz)
+}
+
+func (keeper Keeper) IterateClaimRecords(ctx sdk.Context, cb func(record ClaimRecord) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), ClaimRecordKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record ClaimRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) GetAllClaimRecords(ctx sdk.Context) (records []ClaimRecord) {
+	keeper.IterateClaimRecords(ctx, func(record ClaimRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (k Keeper) Oracle(ctx sdk.Context) (res sdk.AccAddress) {
+	k.paramstore.Get(ctx, KeyOracle, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return Params{
+		Oracle: k.Oracle(ctx),
+	}
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,54 @@
+package reward
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryOracle:
+			return queryOracle(ctx, req, k)
+		case QueryClaim:
+			return queryClaim(ctx, path[1:], req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown reward query endpoint")
+		}
+	}
+}
+
+func queryOracle(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	oracle := k.Oracle(ctx)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, oracle)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// path: claim/[fingerprint]/[creator]
+func queryClaim(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) < 2 {
+		return nil, sdk.ErrUnknownRequest("missing fingerprint or creator")
+	}
+	creator, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(err.Error())
+	}
+	record, found := k.GetClaimRecord(ctx, path[0], creator)
+	if !found {
+		return nil, ErrClaimNotFound(k.Codespace())
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, record)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
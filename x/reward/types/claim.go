@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Claim is a single reward payout to a creator
+type Claim struct {
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// ClaimRecord records a paid claim, so that each creator can only be rewarded
+// once for the same content
+type ClaimRecord struct {
+	Fingerprint string         `json:"fingerprint" yaml:"fingerprint"`
+	Creator     sdk.AccAddress `json:"creator" yaml:"creator"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	Height      int64          `json:"height" yaml:"height"`
+}
+
+func (record ClaimRecord) String() string {
+	return fmt.Sprintf(`ClaimRecord:
+  Fingerprint: %s
+  Creator:     %s
+  Amount:      %s
+  Height:      %d`, record.Fingerprint, record.Creator, record.Amount, record.Height)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidFingerprint sdk.CodeType = 101
+	CodeInvalidClaims      sdk.CodeType = 102
+	CodeRewardClaimed      sdk.CodeType = 103
+	CodeClaimNotFound      sdk.CodeType = 104
+)
+
+func ErrInvalidOracle(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, sdk.CodeInvalidAddress, "oracle address is invalid")
+}
+
+func ErrInvalidFingerprint(codespace sdk.CodespaceType, fingerprint string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFingerprint, "invalid content fingerprint: %s", fingerprint)
+}
+
+func ErrInvalidClaims(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClaims, "invalid claims: %s", msg)
+}
+
+func ErrRewardClaimed(codespace sdk.CodespaceType, fingerprint string, creator sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeRewardClaimed, "reward for %s already claimed by %s", fingerprint, creator)
+}
+
+func ErrClaimNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeClaimNotFound, "claim record not found")
+}
@@ -0,0 +1,9 @@
+package types
+
+var (
+	EventTypeClaimReward = "claim_reward"
+
+	AttributeKeyFingerprint = "fingerprint"
+	AttributeKeyCreator     = "creator"
+	AttributeValueCategory  = ModuleName
+)
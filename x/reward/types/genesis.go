@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	liketypes "github.com/likecoin/likechain/types"
+)
+
+type GenesisState struct {
+	ClaimRecords []ClaimRecord `json:"claim_records" yaml:"claim_records"`
+	Params       Params        `json:"params" yaml:"params"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	claimed := make(map[string]bool)
+	for _, record := range data.ClaimRecords {
+		if !liketypes.ValidateFingerprint(record.Fingerprint) {
+			return fmt.Errorf("invalid fingerprint in genesis claim records: %s", record.Fingerprint)
+		}
+		if record.Creator.Empty() {
+			return fmt.Errorf("empty creator in genesis claim records for fingerprint: %s", record.Fingerprint)
+		}
+		key := string(GetClaimRecordKey(record.Fingerprint, record.Creator))
+		if claimed[key] {
+			return fmt.Errorf("duplicated genesis claim record: %s, %s", record.Fingerprint, record.Creator)
+		}
+		claimed[key] = true
+	}
+	return nil
+}
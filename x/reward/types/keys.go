@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "reward"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+var (
+	ClaimRecordKey = []byte{0x11}
+)
+
+// claim records are keyed by creator address followed by content fingerprint
+func GetClaimRecordKey(fingerprint string, creator sdk.AccAddress) []byte {
+	key := append(ClaimRecordKey, creator.Bytes()...)
+	return append(key, []byte(fingerprint)...)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	liketypes "github.com/likecoin/likechain/types"
+)
+
+var _ sdk.Msg = &MsgClaimReward{}
+
+// MsgClaimReward pays rewards from the oracle to the creators of a content
+type MsgClaimReward struct {
+	Oracle      sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Fingerprint string         `json:"fingerprint" yaml:"fingerprint"`
+	Claims      []Claim        `json:"claims" yaml:"claims"`
+}
+
+func NewMsgClaimReward(oracle sdk.AccAddress, fingerprint string, claims []Claim) MsgClaimReward {
+	return MsgClaimReward{
+		Oracle:      oracle,
+		Fingerprint: fingerprint,
+		Claims:      claims,
+	}
+}
+
+func (msg MsgClaimReward) Route() string { return RouterKey }
+func (msg MsgClaimReward) Type() string  { return "claim_reward" }
+
+func (msg MsgClaimReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Oracle}
+}
+
+func (msg MsgClaimReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgClaimReward) ValidateBasic() sdk.Error {
+	if msg.Oracle.Empty() {
+		return ErrInvalidOracle(DefaultCodespace)
+	}
+	if !liketypes.ValidateFingerprint(msg.Fingerprint) {
+		return ErrInvalidFingerprint(DefaultCodespace, msg.Fingerprint)
+	}
+	if len(msg.Claims) == 0 {
+		return ErrInvalidClaims(DefaultCodespace, "no claims")
+	}
+	creators := make(map[string]bool)
+	for _, claim := range msg.Claims {
+		if claim.Creator.Empty() {
+			return ErrInvalidClaims(DefaultCodespace, "missing creator address")
+		}
+		if !claim.Amount.IsValid() || claim.Amount.Empty() {
+			return sdk.ErrInvalidCoins(claim.Amount.String())
+		}
+		creator := claim.Creator.String()
+		if creators[creator] {
+			return ErrInvalidClaims(DefaultCodespace, "duplicated creator "+creator)
+		}
+		creators[creator] = true
+	}
+	return nil
+}
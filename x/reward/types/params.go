@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+type Params struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+var (
+	KeyOracle = []byte("Oracle")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyOracle, Value: &p.Oracle},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Reward Oracle: %s`, p.Oracle)
+}
@@ -0,0 +1,6 @@
+package types
+
+const (
+	QueryOracle = "oracle"
+	QueryClaim  = "claim"
+)
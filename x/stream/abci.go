@@ -0,0 +1,15 @@
+package stream
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker settles all streams which have fully streamed to their payees
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	for _, stream := range keeper.GetEndedStreams(ctx, ctx.BlockHeight()) {
+		err := keeper.SettleStream(ctx, stream)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
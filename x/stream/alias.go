@@ -0,0 +1,54 @@
+package stream
+
+import (
+	"github.com/likecoin/likechain/x/stream/types"
+)
+
+const (
+	ModuleName      = types.ModuleName
+	StoreKey        = types.StoreKey
+	QuerierRoute    = types.QuerierRoute
+	RouterKey       = types.RouterKey
+	QueryStreamInfo = types.QueryStreamInfo
+	MaxDuration     = types.MaxDuration
+)
+
+var (
+	ModuleCdc               = types.ModuleCdc
+	NewMsgOpenStream        = types.NewMsgOpenStream
+	NewMsgCloseStream       = types.NewMsgCloseStream
+	NewMsgWithdrawStream    = types.NewMsgWithdrawStream
+	ErrInvalidDuration      = types.ErrInvalidDuration
+	ErrStreamNotFound       = types.ErrStreamNotFound
+	ErrNotStreamParty       = types.ErrNotStreamParty
+	ErrNotStreamPayee       = types.ErrNotStreamPayee
+	ErrNothingStreamed      = types.ErrNothingStreamed
+	DefaultGenesisState     = types.DefaultGenesisState
+	DefaultCodespace        = types.DefaultCodespace
+	ValidateGenesis         = types.ValidateGenesis
+	NextStreamIDKey         = types.NextStreamIDKey
+	StreamKey               = types.StreamKey
+	StreamEndKey            = types.StreamEndKey
+	GetStreamKey            = types.GetStreamKey
+	GetStreamEndPrefix      = types.GetStreamEndPrefix
+	GetStreamEndKey         = types.GetStreamEndKey
+	EventTypeOpenStream     = types.EventTypeOpenStream
+	EventTypeSettleStream   = types.EventTypeSettleStream
+	EventTypeWithdrawStream = types.EventTypeWithdrawStream
+	AttributeKeyStreamID    = types.AttributeKeyStreamID
+	AttributeKeyPayer       = types.AttributeKeyPayer
+	AttributeKeyPayee       = types.AttributeKeyPayee
+	AttributeKeyEndHeight   = types.AttributeKeyEndHeight
+	AttributeKeyRefund      = types.AttributeKeyRefund
+	AttributeValueCategory  = types.AttributeValueCategory
+	RegisterCodec           = types.RegisterCodec
+)
+
+type (
+	MsgOpenStream     = types.MsgOpenStream
+	MsgCloseStream    = types.MsgCloseStream
+	MsgWithdrawStream = types.MsgWithdrawStream
+	Stream            = types.Stream
+	SupplyKeeper      = types.SupplyKeeper
+	GenesisState      = types.GenesisState
+)
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/likecoin/likechain/x/stream/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	streamQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the stream module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	streamQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryStreamInfo(queryRoute, cdc),
+	)...)
+
+	return streamQueryCmd
+}
+
+// GetCmdQueryStreamInfo implements the stream info query command.
+func GetCmdQueryStreamInfo(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "info [stream-id]",
+		Short: "Query an open stream",
+		Long: strings.TrimSpace(`Query an open stream:
+
+$ likecli query stream info 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryStreamInfo, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var stream types.Stream
+			cdc.MustUnmarshalJSON(res, &stream)
+			return cliCtx.PrintOutput(stream)
+		},
+	}
+}
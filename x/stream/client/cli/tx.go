@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/likecoin/likechain/x/stream/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	streamTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Stream transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	streamTxCmd.AddCommand(client.PostCommands(
+		GetCmdOpenStream(cdc),
+		GetCmdCloseStream(cdc),
+		GetCmdWithdrawStream(cdc),
+	)...)
+
+	return streamTxCmd
+}
+
+// GetCmdOpenStream implements the open stream command
+func GetCmdOpenStream(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open [payee] [amount] [duration]",
+		Short: "lock amount and stream it to payee over duration in blocks",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			payee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			duration, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenStream(cliCtx.GetFromAddress(), payee, amount, duration)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// GetCmdCloseStream implements the close stream command
+func GetCmdCloseStream(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close [stream-id]",
+		Short: "close a stream as its payer or payee, settling the streamed amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseStream(cliCtx.GetFromAddress(), id)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+// GetCmdWithdrawStream implements the withdraw stream command
+func GetCmdWithdrawStream(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [stream-id]",
+		Short: "withdraw the streamed amount as the payee, keeping the stream open",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawStream(cliCtx.GetFromAddress(), id)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package stream
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
+	// make sure the module account holding the locked coins exists
+	keeper.supplyKeeper.GetModuleAccount(ctx, ModuleName)
+	keeper.SetNextStreamID(ctx, genesisState.NextStreamID)
+	for _, stream := range genesisState.Streams {
+		keeper.SetStream(ctx, stream)
+	}
+	return nil
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	nextStreamID := keeper.GetNextStreamID(ctx)
+	streams := keeper.GetAllStreams(ctx)
+	return GenesisState{
+		NextStreamID: nextStreamID,
+		Streams:      streams,
+	}
+}
@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgOpenStream:
+			return handleMsgOpenStream(ctx, msg, keeper)
+		case MsgCloseStream:
+			return handleMsgCloseStream(ctx, msg, keeper)
+		case MsgWithdrawStream:
+			return handleMsgWithdrawStream(ctx, msg, keeper)
+		default:
+			errMsg := fmt.Sprintf("unrecognized stream message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgOpenStream(ctx sdk.Context, msg MsgOpenStream, keeper Keeper) sdk.Result {
+	if keeper.BlacklistedAddr(msg.Payee) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive streams", msg.Payee)).Result()
+	}
+	stream, err := keeper.OpenStream(ctx, msg.Payer, msg.Payee, msg.Amount, msg.Duration)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeOpenStream,
+			sdk.NewAttribute(AttributeKeyStreamID, strconv.FormatUint(stream.ID, 10)),
+			sdk.NewAttribute(AttributeKeyPayer, stream.Payer.String()),
+			sdk.NewAttribute(AttributeKeyPayee, stream.Payee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.String()),
+			sdk.NewAttribute(AttributeKeyEndHeight, strconv.FormatInt(stream.EndHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   keeper.cdc.MustMarshalBinaryLengthPrefixed(stream.ID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCloseStream(ctx sdk.Context, msg MsgCloseStream, keeper Keeper) sdk.Result {
+	stream, found := keeper.GetStream(ctx, msg.StreamID)
+	if !found {
+		return ErrStreamNotFound(keeper.Codespace(), msg.StreamID).Result()
+	}
+	if !stream.Payer.Equals(msg.Sender) && !stream.Payee.Equals(msg.Sender) {
+		return ErrNotStreamParty(keeper.Codespace(), msg.StreamID).Result()
+	}
+	err := keeper.SettleStream(ctx, stream)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgWithdrawStream(ctx sdk.Context, msg MsgWithdrawStream, keeper Keeper) sdk.Result {
+	stream, found := keeper.GetStream(ctx, msg.StreamID)
+	if !found {
+		return ErrStreamNotFound(keeper.Codespace(), msg.StreamID).Result()
+	}
+	if !stream.Payee.Equals(msg.Payee) {
+		return ErrNotStreamPayee(keeper.Codespace(), msg.StreamID).Result()
+	}
+	amount, err := keeper.WithdrawStream(ctx, stream)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeWithdrawStream,
+			sdk.NewAttribute(AttributeKeyStreamID, strconv.FormatUint(stream.ID, 10)),
+			sdk.NewAttribute(AttributeKeyPayee, stream.Payee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payee.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,33 @@
+package stream
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all stream invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(ModuleName, "module-account", ModuleAccountInvariant(keeper))
+}
+
+// ModuleAccountInvariant checks that the module account coins equal the sum of
+// the amounts still locked in open streams
+func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedLocked sdk.Coins
+
+		keeper.IterateStreams(ctx, func(stream Stream) bool {
+			expectedLocked = expectedLocked.Add(stream.Locked())
+			return false
+		})
+
+		macc := keeper.supplyKeeper.GetModuleAccount(ctx, ModuleName)
+		// IsEqual panics on different denoms, so compare both ways instead
+		broken := !macc.GetCoins().IsAllGTE(expectedLocked) || !expectedLocked.IsAllGTE(macc.GetCoins())
+
+		return sdk.FormatInvariant(ModuleName, "locked amounts",
+			fmt.Sprintf("\tstream ModuleAccount coins: %s\n\tsum of locked amounts:      %s\n",
+				macc.GetCoins(), expectedLocked)), broken
+	}
+}
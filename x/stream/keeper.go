@@ -0,0 +1,179 @@
+package stream
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Keeper struct {
+	storeKey         sdk.StoreKey
+	cdc              *codec.Codec
+	supplyKeeper     SupplyKeeper
+	blacklistedAddrs map[string]bool
+	codespace        sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, supplyKeeper SupplyKeeper, blacklistedAddrs map[string]bool, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		supplyKeeper:     supplyKeeper,
+		blacklistedAddrs: blacklistedAddrs,
+		codespace:        codespace,
+	}
+}
+
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
+}
+
+// BlacklistedAddr checks if the address is not allowed to receive streams,
+// i.e. a module account
+func (keeper Keeper) BlacklistedAddr(addr sdk.AccAddress) bool {
+	return keeper.blacklistedAddrs[addr.String()]
+}
+
+func (keeper Keeper) GetNextStreamID(ctx sdk.Context) (id uint64) {
+	bz := ctx.KVStore(keeper.storeKey).Get(NextStreamIDKey)
+	if bz == nil {
+		return 1
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+func (keeper Keeper) SetNextStreamID(ctx sdk.Context, id uint64) {
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(id)
+	ctx.KVStore(keeper.storeKey).Set(NextStreamIDKey, bz)
+}
+
+func (keeper Keeper) GetStream(ctx sdk.Context, id uint64) (stream Stream, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(GetStreamKey(id))
+	if bz == nil {
+		return stream, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &stream)
+	return stream, true
+}
+
+// SetStream stores the stream and indexes it by its end height
+func (keeper Keeper) SetStream(ctx sdk.Context, stream Stream) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(stream)
+	store.Set(GetStreamKey(stream.ID), bz)
+	store.Set(GetStreamEndKey(stream.EndHeight, stream.ID), []byte{})
+}
+
+func (keeper Keeper) DeleteStream(ctx sdk.Context, stream Stream) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(GetStreamKey(stream.ID))
+	store.Delete(GetStreamEndKey(stream.EndHeight, stream.ID))
+}
+
+func (keeper Keeper) IterateStreams(ctx sdk.Context, cb func(stream Stream) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), StreamKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream Stream
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) GetAllStreams(ctx sdk.Context) (streams []Stream) {
+	keeper.IterateStreams(ctx, func(stream Stream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// GetEndedStreams returns the streams with end height not after the given
+// height, in order of end height and stream ID
+func (keeper Keeper) GetEndedStreams(ctx sdk.Context, height int64) (streams []Stream) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := store.Iterator(StreamEndKey, sdk.PrefixEndBytes(GetStreamEndPrefix(height)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		stream, found := keeper.GetStream(ctx, id)
+		if !found {
+			panic("stream in end height index not found")
+		}
+		streams = append(streams, stream)
+	}
+	return streams
+}
+
+// OpenStream moves the amount from the payer into the module account and
+// creates a stream starting at the current block
+func (keeper Keeper) OpenStream(ctx sdk.Context, payer sdk.AccAddress, payee sdk.AccAddress, amount sdk.Coins, duration int64) (Stream, sdk.Error) {
+	err := keeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, payer, ModuleName, amount)
+	if err != nil {
+		return Stream{}, err
+	}
+	id := keeper.GetNextStreamID(ctx)
+	stream := Stream{
+		ID:          id,
+		Payer:       payer,
+		Payee:       payee,
+		Amount:      amount,
+		StartHeight: ctx.BlockHeight(),
+		EndHeight:   ctx.BlockHeight() + duration,
+	}
+	keeper.SetStream(ctx, stream)
+	keeper.SetNextStreamID(ctx, id+1)
+	return stream, nil
+}
+
+// WithdrawStream pays the streamed part not yet withdrawn to the payee and
+// keeps the stream open
+func (keeper Keeper) WithdrawStream(ctx sdk.Context, stream Stream) (sdk.Coins, sdk.Error) {
+	amount := stream.Withdrawable(ctx.BlockHeight())
+	if amount.Empty() {
+		return nil, ErrNothingStreamed(keeper.Codespace(), stream.ID)
+	}
+	err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, stream.Payee, amount)
+	if err != nil {
+		return nil, err
+	}
+	stream.Withdrawn = stream.Withdrawn.Add(amount)
+	keeper.SetStream(ctx, stream)
+	return amount, nil
+}
+
+// SettleStream pays the streamed part to the payee, refunds the rest to the
+// payer and removes the stream
+func (keeper Keeper) SettleStream(ctx sdk.Context, stream Stream) sdk.Error {
+	paid, refund := stream.Settle(ctx.BlockHeight())
+	if !paid.Empty() {
+		err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, stream.Payee, paid)
+		if err != nil {
+			return err
+		}
+	}
+	if !refund.Empty() {
+		err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, stream.Payer, refund)
+		if err != nil {
+			return err
+		}
+	}
+	keeper.DeleteStream(ctx, stream)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSettleStream,
+			sdk.NewAttribute(AttributeKeyStreamID, strconv.FormatUint(stream.ID, 10)),
+			sdk.NewAttribute(AttributeKeyPayee, stream.Payee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, paid.String()),
+			sdk.NewAttribute(AttributeKeyPayer, stream.Payer.String()),
+			sdk.NewAttribute(AttributeKeyRefund, refund.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,42 @@
+package stream
+
+import (
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryStreamInfo:
+			return queryStreamInfo(ctx, path[1:], req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown stream query endpoint")
+		}
+	}
+}
+
+func queryStreamInfo(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing stream ID")
+	}
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid stream ID", err.Error()))
+	}
+	stream, found := k.GetStream(ctx, id)
+	if !found {
+		return nil, ErrStreamNotFound(k.Codespace(), id)
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, stream)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgOpenStream{}, "likechain/MsgOpenStream", nil)
+	cdc.RegisterConcrete(MsgCloseStream{}, "likechain/MsgCloseStream", nil)
+	cdc.RegisterConcrete(MsgWithdrawStream{}, "likechain/MsgWithdrawStream", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidDuration sdk.CodeType = 101
+	CodeStreamNotFound  sdk.CodeType = 102
+	CodeNotStreamParty  sdk.CodeType = 103
+	CodeNotStreamPayee  sdk.CodeType = 104
+	CodeNothingStreamed sdk.CodeType = 105
+)
+
+func ErrInvalidDuration(codespace sdk.CodespaceType, duration int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDuration, "invalid stream duration: %d", duration)
+}
+
+func ErrStreamNotFound(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeStreamNotFound, "stream not found: %d", id)
+}
+
+func ErrNotStreamParty(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotStreamParty, "sender is neither the payer nor the payee of stream: %d", id)
+}
+
+func ErrNotStreamPayee(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotStreamPayee, "sender is not the payee of stream: %d", id)
+}
+
+func ErrNothingStreamed(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNothingStreamed, "nothing to withdraw from stream: %d", id)
+}
@@ -0,0 +1,14 @@
+package types
+
+var (
+	EventTypeOpenStream     = "open_stream"
+	EventTypeSettleStream   = "settle_stream"
+	EventTypeWithdrawStream = "withdraw_stream"
+
+	AttributeKeyStreamID   = "stream_id"
+	AttributeKeyPayer      = "payer"
+	AttributeKeyPayee      = "payee"
+	AttributeKeyEndHeight  = "end_height"
+	AttributeKeyRefund     = "refund"
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+type GenesisState struct {
+	NextStreamID uint64   `json:"next_stream_id" yaml:"next_stream_id"`
+	Streams      []Stream `json:"streams" yaml:"streams"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextStreamID: 1,
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if data.NextStreamID == 0 {
+		return fmt.Errorf("next stream ID must be positive")
+	}
+	ids := make(map[uint64]bool)
+	for _, stream := range data.Streams {
+		if stream.ID == 0 || stream.ID >= data.NextStreamID {
+			return fmt.Errorf("invalid stream ID in genesis: %d", stream.ID)
+		}
+		if ids[stream.ID] {
+			return fmt.Errorf("duplicated stream ID in genesis: %d", stream.ID)
+		}
+		if stream.Payer.Empty() || stream.Payee.Empty() {
+			return fmt.Errorf("empty payer or payee for stream in genesis: %d", stream.ID)
+		}
+		if !stream.Amount.IsValid() || stream.Amount.Empty() {
+			return fmt.Errorf("invalid amount for stream in genesis: %d", stream.ID)
+		}
+		if !stream.Withdrawn.IsValid() || !stream.Amount.IsAllGTE(stream.Withdrawn) {
+			return fmt.Errorf("invalid withdrawn amount for stream in genesis: %d", stream.ID)
+		}
+		if stream.EndHeight <= stream.StartHeight {
+			return fmt.Errorf("invalid heights for stream in genesis: %d", stream.ID)
+		}
+		ids[stream.ID] = true
+	}
+	return nil
+}
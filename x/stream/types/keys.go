@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "stream"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+var (
+	NextStreamIDKey = []byte{0x01}
+	StreamKey       = []byte{0x11}
+	StreamEndKey    = []byte{0x12}
+)
+
+func GetStreamKey(id uint64) []byte {
+	return append(StreamKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetStreamEndPrefix(endHeight int64) []byte {
+	return append(StreamEndKey, sdk.Uint64ToBigEndian(uint64(endHeight))...)
+}
+
+// end index entries are keyed by end height followed by stream ID, so streams
+// can be settled in order of their end height
+func GetStreamEndKey(endHeight int64, id uint64) []byte {
+	return append(GetStreamEndPrefix(endHeight), sdk.Uint64ToBigEndian(id)...)
+}
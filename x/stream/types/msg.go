@@ -0,0 +1,128 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgOpenStream{}
+var _ sdk.Msg = &MsgCloseStream{}
+var _ sdk.Msg = &MsgWithdrawStream{}
+
+// MaxDuration bounds the stream duration in blocks, about 10 years at 5
+// seconds per block, so the end height never overflows
+const MaxDuration int64 = 10 * 365 * 24 * 60 * 60 / 5
+
+// MsgOpenStream locks the amount from the payer and streams it to the payee
+// over the duration in blocks
+type MsgOpenStream struct {
+	Payer    sdk.AccAddress `json:"payer" yaml:"payer"`
+	Payee    sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount   sdk.Coins      `json:"amount" yaml:"amount"`
+	Duration int64          `json:"duration" yaml:"duration"`
+}
+
+func NewMsgOpenStream(payer sdk.AccAddress, payee sdk.AccAddress, amount sdk.Coins, duration int64) MsgOpenStream {
+	return MsgOpenStream{
+		Payer:    payer,
+		Payee:    payee,
+		Amount:   amount,
+		Duration: duration,
+	}
+}
+
+func (msg MsgOpenStream) Route() string { return RouterKey }
+func (msg MsgOpenStream) Type() string  { return "open_stream" }
+
+func (msg MsgOpenStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
+
+func (msg MsgOpenStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgOpenStream) ValidateBasic() sdk.Error {
+	if msg.Payer.Empty() {
+		return sdk.ErrInvalidAddress("missing payer address")
+	}
+	if msg.Payee.Empty() {
+		return sdk.ErrInvalidAddress("missing payee address")
+	}
+	if msg.Payer.Equals(msg.Payee) {
+		return sdk.ErrInvalidAddress("payer and payee are the same address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	if msg.Duration <= 0 || msg.Duration > MaxDuration {
+		return ErrInvalidDuration(DefaultCodespace, msg.Duration)
+	}
+	return nil
+}
+
+// MsgCloseStream closes a stream, paying the streamed part to the payee and
+// refunding the rest to the payer
+type MsgCloseStream struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	StreamID uint64         `json:"stream_id" yaml:"stream_id"`
+}
+
+func NewMsgCloseStream(sender sdk.AccAddress, streamID uint64) MsgCloseStream {
+	return MsgCloseStream{
+		Sender:   sender,
+		StreamID: streamID,
+	}
+}
+
+func (msg MsgCloseStream) Route() string { return RouterKey }
+func (msg MsgCloseStream) Type() string  { return "close_stream" }
+
+func (msg MsgCloseStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgCloseStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCloseStream) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	return nil
+}
+
+// MsgWithdrawStream pays the streamed part not yet withdrawn to the payee and
+// keeps the stream open
+type MsgWithdrawStream struct {
+	Payee    sdk.AccAddress `json:"payee" yaml:"payee"`
+	StreamID uint64         `json:"stream_id" yaml:"stream_id"`
+}
+
+func NewMsgWithdrawStream(payee sdk.AccAddress, streamID uint64) MsgWithdrawStream {
+	return MsgWithdrawStream{
+		Payee:    payee,
+		StreamID: streamID,
+	}
+}
+
+func (msg MsgWithdrawStream) Route() string { return RouterKey }
+func (msg MsgWithdrawStream) Type() string  { return "withdraw_stream" }
+
+func (msg MsgWithdrawStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payee}
+}
+
+func (msg MsgWithdrawStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgWithdrawStream) ValidateBasic() sdk.Error {
+	if msg.Payee.Empty() {
+		return sdk.ErrInvalidAddress("missing payee address")
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package types
+
+const (
+	QueryStreamInfo = "stream_info"
+)
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Stream locks Amount from Payer in the module account and releases it to
+// Payee linearly per block from StartHeight to EndHeight. Withdrawn is the
+// part already paid to Payee while the stream is open.
+type Stream struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Payer       sdk.AccAddress `json:"payer" yaml:"payer"`
+	Payee       sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	StartHeight int64          `json:"start_height" yaml:"start_height"`
+	EndHeight   int64          `json:"end_height" yaml:"end_height"`
+	Withdrawn   sdk.Coins      `json:"withdrawn" yaml:"withdrawn"`
+}
+
+// Streamed returns the part of the amount streamed to the payee by the given
+// height, including the withdrawn part. Amounts are rounded down in favour of
+// the payer.
+func (stream Stream) Streamed(height int64) sdk.Coins {
+	if height >= stream.EndHeight {
+		return stream.Amount
+	}
+	elapsed := height - stream.StartHeight
+	if elapsed <= 0 {
+		return sdk.NewCoins()
+	}
+	duration := stream.EndHeight - stream.StartHeight
+	streamed := sdk.NewCoins()
+	for _, coin := range stream.Amount {
+		amount := coin.Amount.MulRaw(elapsed).QuoRaw(duration)
+		streamed = streamed.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+	}
+	return streamed
+}
+
+// Withdrawable returns the streamed part not yet withdrawn by the payee
+func (stream Stream) Withdrawable(height int64) sdk.Coins {
+	return stream.Streamed(height).Sub(stream.Withdrawn)
+}
+
+// Locked returns the part still held in the module account
+func (stream Stream) Locked() sdk.Coins {
+	return stream.Amount.Sub(stream.Withdrawn)
+}
+
+// Settle splits the locked amount at the given height into the part paid to
+// the payee and the part refunded to the payer
+func (stream Stream) Settle(height int64) (paid sdk.Coins, refund sdk.Coins) {
+	streamed := stream.Streamed(height)
+	return streamed.Sub(stream.Withdrawn), stream.Amount.Sub(streamed)
+}
+
+func (stream Stream) String() string {
+	return fmt.Sprintf(`Stream %d:
+  Payer:        %s
+  Payee:        %s
+  Amount:       %s
+  Start Height: %d
+  End Height:   %d
+  Withdrawn:    %s`, stream.ID, stream.Payer, stream.Payee, stream.Amount, stream.StartHeight, stream.EndHeight,
+		stream.Withdrawn)
+}
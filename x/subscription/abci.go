@@ -0,0 +1,21 @@
+package subscription
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker executes all subscription payments due at this block and
+// deletes the subscriptions paused for longer than the paused timeout
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	height := ctx.BlockHeight()
+	for _, sub := range keeper.GetDueSubscriptions(ctx, height) {
+		keeper.ExecutePayment(ctx, sub)
+	}
+	expiry := height - keeper.PausedTimeout(ctx)
+	if expiry < 0 {
+		return
+	}
+	for _, sub := range keeper.GetPausedSubscriptions(ctx, expiry) {
+		keeper.ExpireSubscription(ctx, sub)
+	}
+}
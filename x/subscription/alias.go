@@ -0,0 +1,72 @@
+package subscription
+
+import (
+	"github.com/likecoin/likechain/x/subscription/types"
+)
+
+const (
+	ModuleName         = types.ModuleName
+	StoreKey           = types.StoreKey
+	QuerierRoute       = types.QuerierRoute
+	RouterKey          = types.RouterKey
+	QuerySubscription  = types.QuerySubscription
+	QuerySubscriptions = types.QuerySubscriptions
+	QueryParams        = types.QueryParams
+	MaxInterval        = types.MaxInterval
+	MaxPeriods         = types.MaxPeriods
+)
+
+var (
+	ModuleCdc                   = types.ModuleCdc
+	NewMsgCreateSubscription    = types.NewMsgCreateSubscription
+	NewMsgCancelSubscription    = types.NewMsgCancelSubscription
+	NewMsgResumeSubscription    = types.NewMsgResumeSubscription
+	ErrInvalidInterval          = types.ErrInvalidInterval
+	ErrInvalidMaxPeriods        = types.ErrInvalidMaxPeriods
+	ErrSubscriptionNotFound     = types.ErrSubscriptionNotFound
+	ErrNotSubscriptionParty     = types.ErrNotSubscriptionParty
+	ErrNotSubscriptionPayer     = types.ErrNotSubscriptionPayer
+	ErrSubscriptionNotPaused    = types.ErrSubscriptionNotPaused
+	DefaultGenesisState         = types.DefaultGenesisState
+	DefaultParams               = types.DefaultParams
+	DefaultCodespace            = types.DefaultCodespace
+	ValidateGenesis             = types.ValidateGenesis
+	NextSubscriptionIDKey       = types.NextSubscriptionIDKey
+	SubscriptionKey             = types.SubscriptionKey
+	DuePaymentKey               = types.DuePaymentKey
+	PayerSubscriptionKey        = types.PayerSubscriptionKey
+	PausedSubscriptionKey       = types.PausedSubscriptionKey
+	GetSubscriptionKey          = types.GetSubscriptionKey
+	GetDuePaymentPrefix         = types.GetDuePaymentPrefix
+	GetDuePaymentKey            = types.GetDuePaymentKey
+	GetPayerSubscriptionPrefix  = types.GetPayerSubscriptionPrefix
+	GetPayerSubscriptionKey     = types.GetPayerSubscriptionKey
+	GetPausedSubscriptionPrefix = types.GetPausedSubscriptionPrefix
+	GetPausedSubscriptionKey    = types.GetPausedSubscriptionKey
+	KeyMinInterval              = types.KeyMinInterval
+	KeyPeriodGas                = types.KeyPeriodGas
+	KeyPausedTimeout            = types.KeyPausedTimeout
+	EventTypeCreateSubscription = types.EventTypeCreateSubscription
+	EventTypeCancelSubscription = types.EventTypeCancelSubscription
+	EventTypeResumeSubscription = types.EventTypeResumeSubscription
+	EventTypeSubscriptionPaid   = types.EventTypeSubscriptionPaid
+	EventTypePauseSubscription  = types.EventTypePauseSubscription
+	EventTypeExpireSubscription = types.EventTypeExpireSubscription
+	AttributeKeySubscriptionID  = types.AttributeKeySubscriptionID
+	AttributeKeyPayer           = types.AttributeKeyPayer
+	AttributeKeyPayee           = types.AttributeKeyPayee
+	AttributeKeyPeriod          = types.AttributeKeyPeriod
+	AttributeValueCategory      = types.AttributeValueCategory
+	RegisterCodec               = types.RegisterCodec
+)
+
+type (
+	MsgCreateSubscription = types.MsgCreateSubscription
+	MsgCancelSubscription = types.MsgCancelSubscription
+	MsgResumeSubscription = types.MsgResumeSubscription
+	Subscription          = types.Subscription
+	Subscriptions         = types.Subscriptions
+	BankKeeper            = types.BankKeeper
+	GenesisState          = types.GenesisState
+	Params                = types.Params
+)
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/likecoin/likechain/x/subscription/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	subscriptionQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the subscription module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	subscriptionQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQuerySubscription(queryRoute, cdc),
+		GetCmdQuerySubscriptions(queryRoute, cdc),
+		GetCmdQueryParams(queryRoute, cdc),
+	)...)
+
+	return subscriptionQueryCmd
+}
+
+// GetCmdQuerySubscription implements the subscription query command.
+func GetCmdQuerySubscription(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subscription [subscription-id]",
+		Short: "Query a subscription",
+		Long: strings.TrimSpace(`Query a subscription:
+
+$ likecli query subscription subscription 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QuerySubscription, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var sub types.Subscription
+			cdc.MustUnmarshalJSON(res, &sub)
+			return cliCtx.PrintOutput(sub)
+		},
+	}
+}
+
+// GetCmdQuerySubscriptions implements the subscriptions by payer query command.
+func GetCmdQuerySubscriptions(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subscriptions [payer]",
+		Short: "Query the subscriptions paid by an address",
+		Long: strings.TrimSpace(`Query the subscriptions paid by an address:
+
+$ likecli query subscription subscriptions cosmos1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QuerySubscriptions, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var subs types.Subscriptions
+			cdc.MustUnmarshalJSON(res, &subs)
+			return cliCtx.PrintOutput(subs)
+		},
+	}
+}
+
+// GetCmdQueryParams implements the subscription params query command.
+func GetCmdQueryParams(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current subscription parameters",
+		Long: strings.TrimSpace(`Query the current subscription parameters:
+
+$ likecli query subscription params
+`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", storeName, types.QueryParams))
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
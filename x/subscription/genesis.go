@@ -0,0 +1,26 @@
+package subscription
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, genesisState GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, genesisState.Params)
+	keeper.SetNextSubscriptionID(ctx, genesisState.NextSubscriptionID)
+	for _, sub := range genesisState.Subscriptions {
+		keeper.SetSubscription(ctx, sub)
+	}
+	return nil
+}
+
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	nextSubscriptionID := keeper.GetNextSubscriptionID(ctx)
+	subs := keeper.GetAllSubscriptions(ctx)
+	params := keeper.GetParams(ctx)
+	return GenesisState{
+		NextSubscriptionID: nextSubscriptionID,
+		Subscriptions:      subs,
+		Params:             params,
+	}
+}
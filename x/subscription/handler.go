@@ -0,0 +1,125 @@
+package subscription
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgCreateSubscription:
+			return handleMsgCreateSubscription(ctx, msg, keeper)
+		case MsgCancelSubscription:
+			return handleMsgCancelSubscription(ctx, msg, keeper)
+		case MsgResumeSubscription:
+			return handleMsgResumeSubscription(ctx, msg, keeper)
+		default:
+			errMsg := fmt.Sprintf("unrecognized subscription message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateSubscription(ctx sdk.Context, msg MsgCreateSubscription, keeper Keeper) sdk.Result {
+	if keeper.bankKeeper.BlacklistedAddr(msg.Payee) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive subscriptions", msg.Payee)).Result()
+	}
+	if msg.Interval < keeper.MinInterval(ctx) {
+		return ErrInvalidInterval(keeper.Codespace(), msg.Interval).Result()
+	}
+	// every period is a bank send in EndBlock, which is not metered, so the
+	// payer pays for them up front
+	ctx.GasMeter().ConsumeGas(keeper.PeriodGas(ctx)*msg.MaxPeriods, "subscription periods")
+	id := keeper.GetNextSubscriptionID(ctx)
+	sub := Subscription{
+		ID:                id,
+		Payer:             msg.Payer,
+		Payee:             msg.Payee,
+		Amount:            msg.Amount,
+		Interval:          msg.Interval,
+		MaxPeriods:        msg.MaxPeriods,
+		NextPaymentHeight: ctx.BlockHeight() + msg.Interval,
+	}
+	keeper.SetSubscription(ctx, sub)
+	keeper.SetNextSubscriptionID(ctx, id+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCreateSubscription,
+			sdk.NewAttribute(AttributeKeySubscriptionID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(AttributeKeyPayer, msg.Payer.String()),
+			sdk.NewAttribute(AttributeKeyPayee, msg.Payee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Data:   keeper.cdc.MustMarshalBinaryLengthPrefixed(id),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelSubscription(ctx sdk.Context, msg MsgCancelSubscription, keeper Keeper) sdk.Result {
+	sub, found := keeper.GetSubscription(ctx, msg.SubscriptionID)
+	if !found {
+		return ErrSubscriptionNotFound(keeper.Codespace(), msg.SubscriptionID).Result()
+	}
+	if !sub.Payer.Equals(msg.Sender) && !sub.Payee.Equals(msg.Sender) {
+		return ErrNotSubscriptionParty(keeper.Codespace(), msg.SubscriptionID).Result()
+	}
+	keeper.DeleteSubscription(ctx, sub)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCancelSubscription,
+			sdk.NewAttribute(AttributeKeySubscriptionID, strconv.FormatUint(sub.ID, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgResumeSubscription(ctx sdk.Context, msg MsgResumeSubscription, keeper Keeper) sdk.Result {
+	sub, found := keeper.GetSubscription(ctx, msg.SubscriptionID)
+	if !found {
+		return ErrSubscriptionNotFound(keeper.Codespace(), msg.SubscriptionID).Result()
+	}
+	if !sub.Payer.Equals(msg.Payer) {
+		return ErrNotSubscriptionPayer(keeper.Codespace(), msg.SubscriptionID).Result()
+	}
+	if !sub.Paused {
+		return ErrSubscriptionNotPaused(keeper.Codespace(), msg.SubscriptionID).Result()
+	}
+	sub.Paused = false
+	sub.PausedHeight = 0
+	sub.NextPaymentHeight = ctx.BlockHeight()
+	keeper.SetSubscription(ctx, sub)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeResumeSubscription,
+			sdk.NewAttribute(AttributeKeySubscriptionID, strconv.FormatUint(sub.ID, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payer.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
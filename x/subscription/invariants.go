@@ -0,0 +1,79 @@
+package subscription
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all subscription invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(ModuleName, "due-payment-index", DuePaymentIndexInvariant(keeper))
+	ir.RegisterRoute(ModuleName, "paused-subscription-index", PausedSubscriptionIndexInvariant(keeper))
+}
+
+// DuePaymentIndexInvariant checks that every active subscription, and only
+// those, is in the due payment index at its next payment height
+func DuePaymentIndexInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(keeper.storeKey)
+		var active, missing int
+
+		keeper.IterateSubscriptions(ctx, func(sub Subscription) bool {
+			if sub.Paused {
+				return false
+			}
+			active++
+			if !store.Has(GetDuePaymentKey(sub.NextPaymentHeight, sub.ID)) {
+				missing++
+			}
+			return false
+		})
+
+		var indexed int
+		iter := sdk.KVStorePrefixIterator(store, DuePaymentKey)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			indexed++
+		}
+
+		broken := missing != 0 || indexed != active
+
+		return sdk.FormatInvariant(ModuleName, "due payment index",
+			fmt.Sprintf("\tactive subscriptions: %d\n\tdue payment index entries: %d\n\tsubscriptions missing from index: %d\n",
+				active, indexed, missing)), broken
+	}
+}
+
+// PausedSubscriptionIndexInvariant checks that every paused subscription, and
+// only those, is in the paused subscription index at its paused height
+func PausedSubscriptionIndexInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(keeper.storeKey)
+		var paused, missing int
+
+		keeper.IterateSubscriptions(ctx, func(sub Subscription) bool {
+			if !sub.Paused {
+				return false
+			}
+			paused++
+			if !store.Has(GetPausedSubscriptionKey(sub.PausedHeight, sub.ID)) {
+				missing++
+			}
+			return false
+		})
+
+		var indexed int
+		iter := sdk.KVStorePrefixIterator(store, PausedSubscriptionKey)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			indexed++
+		}
+
+		broken := missing != 0 || indexed != paused
+
+		return sdk.FormatInvariant(ModuleName, "paused subscription index",
+			fmt.Sprintf("\tpaused subscriptions: %d\n\tpaused subscription index entries: %d\n\tsubscriptions missing from index: %d\n",
+				paused, indexed, missing)), broken
+	}
+}
@@ -0,0 +1,223 @@
+package subscription
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	DefaultParamspace = ModuleName
+)
+
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramstore params.Subspace
+	bankKeeper BankKeeper
+	codespace  sdk.CodespaceType
+}
+
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore params.Subspace, bankKeeper BankKeeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramstore: paramstore.WithKeyTable(ParamKeyTable()),
+		bankKeeper: bankKeeper,
+		codespace:  codespace,
+	}
+}
+
+func (keeper Keeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
+}
+
+func (keeper Keeper) GetNextSubscriptionID(ctx sdk.Context) (id uint64) {
+	bz := ctx.KVStore(keeper.storeKey).Get(NextSubscriptionIDKey)
+	if bz == nil {
+		return 1
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+func (keeper Keeper) SetNextSubscriptionID(ctx sdk.Context, id uint64) {
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(id)
+	ctx.KVStore(keeper.storeKey).Set(NextSubscriptionIDKey, bz)
+}
+
+func (keeper Keeper) GetSubscription(ctx sdk.Context, id uint64) (sub Subscription, found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(GetSubscriptionKey(id))
+	if bz == nil {
+		return sub, false
+	}
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &sub)
+	return sub, true
+}
+
+// SetSubscription stores the subscription and updates its indices. Active
+// subscriptions are in the due payment index, paused ones in the paused
+// subscription index.
+func (keeper Keeper) SetSubscription(ctx sdk.Context, sub Subscription) {
+	store := ctx.KVStore(keeper.storeKey)
+	old, found := keeper.GetSubscription(ctx, sub.ID)
+	if found {
+		store.Delete(GetDuePaymentKey(old.NextPaymentHeight, old.ID))
+		store.Delete(GetPausedSubscriptionKey(old.PausedHeight, old.ID))
+	}
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(sub)
+	store.Set(GetSubscriptionKey(sub.ID), bz)
+	store.Set(GetPayerSubscriptionKey(sub.Payer, sub.ID), []byte{})
+	if sub.Paused {
+		store.Set(GetPausedSubscriptionKey(sub.PausedHeight, sub.ID), []byte{})
+	} else {
+		store.Set(GetDuePaymentKey(sub.NextPaymentHeight, sub.ID), []byte{})
+	}
+}
+
+func (keeper Keeper) DeleteSubscription(ctx sdk.Context, sub Subscription) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(GetSubscriptionKey(sub.ID))
+	store.Delete(GetPayerSubscriptionKey(sub.Payer, sub.ID))
+	store.Delete(GetDuePaymentKey(sub.NextPaymentHeight, sub.ID))
+	store.Delete(GetPausedSubscriptionKey(sub.PausedHeight, sub.ID))
+}
+
+func (keeper Keeper) IterateSubscriptions(ctx sdk.Context, cb func(sub Subscription) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), SubscriptionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var sub Subscription
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &sub)
+		if cb(sub) {
+			break
+		}
+	}
+}
+
+func (keeper Keeper) GetAllSubscriptions(ctx sdk.Context) (subs []Subscription) {
+	keeper.IterateSubscriptions(ctx, func(sub Subscription) bool {
+		subs = append(subs, sub)
+		return false
+	})
+	return subs
+}
+
+// getSubscriptionsByIndex returns the subscriptions whose IDs are the last 8
+// bytes of the keys in the given range
+func (keeper Keeper) getSubscriptionsByIndex(ctx sdk.Context, start, end []byte) (subs []Subscription) {
+	iter := ctx.KVStore(keeper.storeKey).Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		sub, found := keeper.GetSubscription(ctx, id)
+		if !found {
+			panic("subscription in index not found")
+		}
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+func (keeper Keeper) GetSubscriptionsByPayer(ctx sdk.Context, payer sdk.AccAddress) []Subscription {
+	prefix := GetPayerSubscriptionPrefix(payer)
+	return keeper.getSubscriptionsByIndex(ctx, prefix, sdk.PrefixEndBytes(prefix))
+}
+
+// GetDueSubscriptions returns the active subscriptions with payments due at
+// or before the given height, in order of payment height and ID
+func (keeper Keeper) GetDueSubscriptions(ctx sdk.Context, height int64) []Subscription {
+	return keeper.getSubscriptionsByIndex(ctx, DuePaymentKey, sdk.PrefixEndBytes(GetDuePaymentPrefix(height)))
+}
+
+// GetPausedSubscriptions returns the subscriptions paused at or before the
+// given height, in order of paused height and ID
+func (keeper Keeper) GetPausedSubscriptions(ctx sdk.Context, height int64) []Subscription {
+	return keeper.getSubscriptionsByIndex(ctx, PausedSubscriptionKey, sdk.PrefixEndBytes(GetPausedSubscriptionPrefix(height)))
+}
+
+// ExecutePayment tries to pay one period of the subscription. On failure the
+// subscription is paused instead of failing the block.
+func (keeper Keeper) ExecutePayment(ctx sdk.Context, sub Subscription) {
+	cacheCtx, write := ctx.CacheContext()
+	err := keeper.bankKeeper.SendCoins(cacheCtx, sub.Payer, sub.Payee, sub.Amount)
+	if err != nil {
+		sub.Paused = true
+		sub.PausedHeight = ctx.BlockHeight()
+		keeper.SetSubscription(ctx, sub)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypePauseSubscription,
+				sdk.NewAttribute(AttributeKeySubscriptionID, strconv.FormatUint(sub.ID, 10)),
+				sdk.NewAttribute(AttributeKeyPayer, sub.Payer.String()),
+			),
+		)
+		return
+	}
+	write()
+	sub.PaidPeriods++
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSubscriptionPaid,
+			sdk.NewAttribute(AttributeKeySubscriptionID, strconv.FormatUint(sub.ID, 10)),
+			sdk.NewAttribute(AttributeKeyPayer, sub.Payer.String()),
+			sdk.NewAttribute(AttributeKeyPayee, sub.Payee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sub.Amount.String()),
+			sdk.NewAttribute(AttributeKeyPeriod, strconv.FormatUint(sub.PaidPeriods, 10)),
+		),
+	)
+	if sub.PaidPeriods >= sub.MaxPeriods {
+		keeper.DeleteSubscription(ctx, sub)
+		return
+	}
+	sub.NextPaymentHeight += sub.Interval
+	keeper.SetSubscription(ctx, sub)
+}
+
+// ExpireSubscription deletes a subscription which stayed paused for longer
+// than the paused timeout
+func (keeper Keeper) ExpireSubscription(ctx sdk.Context, sub Subscription) {
+	keeper.DeleteSubscription(ctx, sub)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeExpireSubscription,
+			sdk.NewAttribute(AttributeKeySubscriptionID, strconv.FormatUint(sub.ID, 10)),
+			sdk.NewAttribute(AttributeKeyPayer, sub.Payer.String()),
+		),
+	)
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func (k Keeper) MinInterval(ctx sdk.Context) (res int64) {
+	k.paramstore.Get(ctx, KeyMinInterval, &res)
+	return
+}
+
+func (k Keeper) PeriodGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, KeyPeriodGas, &res)
+	return
+}
+
+func (k Keeper) PausedTimeout(ctx sdk.Context) (res int64) {
+	k.paramstore.Get(ctx, KeyPausedTimeout, &res)
+	return
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return Params{
+		MinInterval:   k.MinInterval(ctx),
+		PeriodGas:     k.PeriodGas(ctx),
+		PausedTimeout: k.PausedTimeout(ctx),
+	}
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,78 @@
+package subscription
+
+import (
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QuerySubscription:
+			return querySubscription(ctx, path[1:], req, k)
+		case QuerySubscriptions:
+			return querySubscriptions(ctx, path[1:], req, k)
+		case QueryParams:
+			return queryParams(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown subscription query endpoint")
+		}
+	}
+}
+
+func querySubscription(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing subscription ID")
+	}
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid subscription ID", err.Error()))
+	}
+	sub, found := k.GetSubscription(ctx, id)
+	if !found {
+		return nil, ErrSubscriptionNotFound(k.Codespace(), id)
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, sub)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func querySubscriptions(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("missing payer address")
+	}
+	payer, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(err.Error())
+	}
+	subs := Subscriptions(k.GetSubscriptionsByPayer(ctx, payer))
+	if subs == nil {
+		subs = Subscriptions{}
+	}
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, subs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(ModuleCdc, params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateSubscription{}, "likechain/MsgCreateSubscription", nil)
+	cdc.RegisterConcrete(MsgCancelSubscription{}, "likechain/MsgCancelSubscription", nil)
+	cdc.RegisterConcrete(MsgResumeSubscription{}, "likechain/MsgResumeSubscription", nil)
+}
+
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
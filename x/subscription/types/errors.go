@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidInterval       sdk.CodeType = 101
+	CodeInvalidMaxPeriods     sdk.CodeType = 102
+	CodeSubscriptionNotFound  sdk.CodeType = 103
+	CodeNotSubscriptionParty  sdk.CodeType = 104
+	CodeSubscriptionNotPaused sdk.CodeType = 105
+	CodeNotSubscriptionPayer  sdk.CodeType = 106
+)
+
+func ErrInvalidInterval(codespace sdk.CodespaceType, interval int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInterval, "invalid payment interval: %d", interval)
+}
+
+func ErrInvalidMaxPeriods(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMaxPeriods, "max periods must be between 1 and %d", MaxPeriods)
+}
+
+func ErrSubscriptionNotFound(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeSubscriptionNotFound, "subscription not found: %d", id)
+}
+
+func ErrNotSubscriptionParty(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotSubscriptionParty, "sender is neither the payer nor the payee of subscription: %d", id)
+}
+
+func ErrNotSubscriptionPayer(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotSubscriptionPayer, "sender is not the payer of subscription: %d", id)
+}
+
+func ErrSubscriptionNotPaused(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeSubscriptionNotPaused, "subscription is not paused: %d", id)
+}
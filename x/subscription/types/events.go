@@ -0,0 +1,16 @@
+package types
+
+var (
+	EventTypeCreateSubscription = "create_subscription"
+	EventTypeCancelSubscription = "cancel_subscription"
+	EventTypeResumeSubscription = "resume_subscription"
+	EventTypeSubscriptionPaid   = "subscription_paid"
+	EventTypePauseSubscription  = "pause_subscription"
+	EventTypeExpireSubscription = "expire_subscription"
+
+	AttributeKeySubscriptionID = "subscription_id"
+	AttributeKeyPayer          = "payer"
+	AttributeKeyPayee          = "payee"
+	AttributeKeyPeriod         = "period"
+	AttributeValueCategory     = ModuleName
+)
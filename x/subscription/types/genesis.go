@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"math"
+)
+
+type GenesisState struct {
+	NextSubscriptionID uint64         `json:"next_subscription_id" yaml:"next_subscription_id"`
+	Subscriptions      []Subscription `json:"subscriptions" yaml:"subscriptions"`
+	Params             Params         `json:"params" yaml:"params"`
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextSubscriptionID: 1,
+		Params:             DefaultParams(),
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if data.NextSubscriptionID == 0 {
+		return fmt.Errorf("next subscription ID must be positive")
+	}
+	if data.Params.MinInterval <= 0 || data.Params.MinInterval > MaxInterval {
+		return fmt.Errorf("invalid min interval: %d", data.Params.MinInterval)
+	}
+	if data.Params.PeriodGas > math.MaxUint64/MaxPeriods {
+		return fmt.Errorf("period gas too large: %d", data.Params.PeriodGas)
+	}
+	if data.Params.PausedTimeout <= 0 {
+		return fmt.Errorf("paused timeout must be positive: %d", data.Params.PausedTimeout)
+	}
+	ids := make(map[uint64]bool)
+	for _, sub := range data.Subscriptions {
+		if sub.ID == 0 || sub.ID >= data.NextSubscriptionID {
+			return fmt.Errorf("invalid subscription ID in genesis: %d", sub.ID)
+		}
+		if ids[sub.ID] {
+			return fmt.Errorf("duplicated subscription ID in genesis: %d", sub.ID)
+		}
+		if sub.Payer.Empty() || sub.Payee.Empty() {
+			return fmt.Errorf("empty payer or payee for subscription in genesis: %d", sub.ID)
+		}
+		if !sub.Amount.IsValid() || sub.Amount.Empty() {
+			return fmt.Errorf("invalid amount for subscription in genesis: %d", sub.ID)
+		}
+		if sub.Interval <= 0 || sub.Interval > MaxInterval || sub.MaxPeriods > MaxPeriods || sub.PaidPeriods >= sub.MaxPeriods {
+			return fmt.Errorf("invalid periods for subscription in genesis: %d", sub.ID)
+		}
+		ids[sub.ID] = true
+	}
+	return nil
+}
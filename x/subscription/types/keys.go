@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "subscription"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+var (
+	NextSubscriptionIDKey = []byte{0x01}
+	SubscriptionKey       = []byte{0x11}
+	DuePaymentKey         = []byte{0x12}
+	PayerSubscriptionKey  = []byte{0x13}
+	PausedSubscriptionKey = []byte{0x14}
+)
+
+func GetSubscriptionKey(id uint64) []byte {
+	return append(SubscriptionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetDuePaymentPrefix(height int64) []byte {
+	return append(DuePaymentKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// due payment entries are keyed by payment height followed by subscription ID
+func GetDuePaymentKey(height int64, id uint64) []byte {
+	return append(GetDuePaymentPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetPayerSubscriptionPrefix(payer sdk.AccAddress) []byte {
+	return append(PayerSubscriptionKey, payer.Bytes()...)
+}
+
+func GetPayerSubscriptionKey(payer sdk.AccAddress, id uint64) []byte {
+	return append(GetPayerSubscriptionPrefix(payer), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetPausedSubscriptionPrefix(height int64) []byte {
+	return append(PausedSubscriptionKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// paused subscription entries are keyed by paused height followed by
+// subscription ID
+func GetPausedSubscriptionKey(height int64, id uint64) []byte {
+	return append(GetPausedSubscriptionPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
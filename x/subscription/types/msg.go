@@ -0,0 +1,135 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateSubscription{}
+var _ sdk.Msg = &MsgCancelSubscription{}
+var _ sdk.Msg = &MsgResumeSubscription{}
+
+const (
+	// MaxInterval bounds the payment interval in blocks, about 10 years at 5
+	// seconds per block
+	MaxInterval int64 = 10 * 365 * 24 * 60 * 60 / 5
+	// MaxPeriods bounds the number of payments, so together with MaxInterval
+	// the next payment height never overflows
+	MaxPeriods uint64 = 10000
+)
+
+type MsgCreateSubscription struct {
+	Payer      sdk.AccAddress `json:"payer" yaml:"payer"`
+	Payee      sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount     sdk.Coins      `json:"amount" yaml:"amount"`
+	Interval   int64          `json:"interval" yaml:"interval"`
+	MaxPeriods uint64         `json:"max_periods" yaml:"max_periods"`
+}
+
+func NewMsgCreateSubscription(payer sdk.AccAddress, payee sdk.AccAddress, amount sdk.Coins, interval int64, maxPeriods uint64) MsgCreateSubscription {
+	return MsgCreateSubscription{
+		Payer:      payer,
+		Payee:      payee,
+		Amount:     amount,
+		Interval:   interval,
+		MaxPeriods: maxPeriods,
+	}
+}
+
+func (msg MsgCreateSubscription) Route() string { return RouterKey }
+func (msg MsgCreateSubscription) Type() string  { return "create_subscription" }
+
+func (msg MsgCreateSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
+
+func (msg MsgCreateSubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateSubscription) ValidateBasic() sdk.Error {
+	if msg.Payer.Empty() {
+		return sdk.ErrInvalidAddress("missing payer address")
+	}
+	if msg.Payee.Empty() {
+		return sdk.ErrInvalidAddress("missing payee address")
+	}
+	if msg.Payer.Equals(msg.Payee) {
+		return sdk.ErrInvalidAddress("payer and payee are the same address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	if msg.Interval <= 0 || msg.Interval > MaxInterval {
+		return ErrInvalidInterval(DefaultCodespace, msg.Interval)
+	}
+	if msg.MaxPeriods == 0 || msg.MaxPeriods > MaxPeriods {
+		return ErrInvalidMaxPeriods(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgCancelSubscription cancels a subscription, either by its payer or payee
+type MsgCancelSubscription struct {
+	Sender         sdk.AccAddress `json:"sender" yaml:"sender"`
+	SubscriptionID uint64         `json:"subscription_id" yaml:"subscription_id"`
+}
+
+func NewMsgCancelSubscription(sender sdk.AccAddress, id uint64) MsgCancelSubscription {
+	return MsgCancelSubscription{
+		Sender:         sender,
+		SubscriptionID: id,
+	}
+}
+
+func (msg MsgCancelSubscription) Route() string { return RouterKey }
+func (msg MsgCancelSubscription) Type() string  { return "cancel_subscription" }
+
+func (msg MsgCancelSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgCancelSubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCancelSubscription) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	return nil
+}
+
+// MsgResumeSubscription resumes a paused subscription, with the next payment
+// executed at the end of the current block
+type MsgResumeSubscription struct {
+	Payer          sdk.AccAddress `json:"payer" yaml:"payer"`
+	SubscriptionID uint64         `json:"subscription_id" yaml:"subscription_id"`
+}
+
+func NewMsgResumeSubscription(payer sdk.AccAddress, id uint64) MsgResumeSubscription {
+	return MsgResumeSubscription{
+		Payer:          payer,
+		SubscriptionID: id,
+	}
+}
+
+func (msg MsgResumeSubscription) Route() string { return RouterKey }
+func (msg MsgResumeSubscription) Type() string  { return "resume_subscription" }
+
+func (msg MsgResumeSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
+
+func (msg MsgResumeSubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgResumeSubscription) ValidateBasic() sdk.Error {
+	if msg.Payer.Empty() {
+		return sdk.ErrInvalidAddress("missing payer address")
+	}
+	return nil
+}
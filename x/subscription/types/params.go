@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// DefaultMinInterval is about 1 hour at 5 seconds per block
+	DefaultMinInterval int64 = 60 * 60 / 5
+	// DefaultPeriodGas is about the gas of a bank send
+	DefaultPeriodGas uint64 = 10000
+	// DefaultPausedTimeout is about 30 days at 5 seconds per block
+	DefaultPausedTimeout int64 = 30 * 24 * 60 * 60 / 5
+)
+
+type Params struct {
+	// minimum number of blocks between two payments
+	MinInterval int64 `json:"min_interval" yaml:"min_interval"`
+	// gas charged at creation for every period the subscription may pay
+	PeriodGas uint64 `json:"period_gas" yaml:"period_gas"`
+	// number of blocks a subscription may stay paused before it is deleted
+	PausedTimeout int64 `json:"paused_timeout" yaml:"paused_timeout"`
+}
+
+var (
+	KeyMinInterval   = []byte("MinInterval")
+	KeyPeriodGas     = []byte("PeriodGas")
+	KeyPausedTimeout = []byte("PausedTimeout")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyMinInterval, Value: &p.MinInterval},
+		{Key: KeyPeriodGas, Value: &p.PeriodGas},
+		{Key: KeyPausedTimeout, Value: &p.PausedTimeout},
+	}
+}
+
+func DefaultParams() Params {
+	return Params{
+		MinInterval:   DefaultMinInterval,
+		PeriodGas:     DefaultPeriodGas,
+		PausedTimeout: DefaultPausedTimeout,
+	}
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Min Interval:   %d
+  Period Gas:     %d
+  Paused Timeout: %d`, p.MinInterval, p.PeriodGas, p.PausedTimeout)
+}
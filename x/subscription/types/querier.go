@@ -0,0 +1,7 @@
+package types
+
+const (
+	QuerySubscription  = "subscription"
+	QuerySubscriptions = "subscriptions"
+	QueryParams        = "params"
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Subscription pays Amount from Payer to Payee every Interval blocks, for at
+// most MaxPeriods payments
+type Subscription struct {
+	ID                uint64         `json:"id" yaml:"id"`
+	Payer             sdk.AccAddress `json:"payer" yaml:"payer"`
+	Payee             sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount            sdk.Coins      `json:"amount" yaml:"amount"`
+	Interval          int64          `json:"interval" yaml:"interval"`
+	MaxPeriods        uint64         `json:"max_periods" yaml:"max_periods"`
+	PaidPeriods       uint64         `json:"paid_periods" yaml:"paid_periods"`
+	NextPaymentHeight int64          `json:"next_payment_height" yaml:"next_payment_height"`
+	// paused when a payment failed, until the payer resumes it
+	Paused bool `json:"paused" yaml:"paused"`
+	// height of the failed payment, 0 if not paused
+	PausedHeight int64 `json:"paused_height" yaml:"paused_height"`
+}
+
+func (sub Subscription) String() string {
+	return fmt.Sprintf(`Subscription %d:
+  Payer:               %s
+  Payee:               %s
+  Amount:              %s
+  Interval:            %d
+  Max Periods:         %d
+  Paid Periods:        %d
+  Next Payment Height: %d
+  Paused:              %t
+  Paused Height:       %d`, sub.ID, sub.Payer, sub.Payee, sub.Amount, sub.Interval,
+		sub.MaxPeriods, sub.PaidPeriods, sub.NextPaymentHeight, sub.Paused, sub.PausedHeight)
+}
+
+type Subscriptions []Subscription
+
+func (subs Subscriptions) String() string {
+	out := ""
+	for _, sub := range subs {
+		out += sub.String() + "\n"
+	}
+	return out
+}
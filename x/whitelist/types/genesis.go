@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,5 +16,15 @@ func DefaultGenesisState() GenesisState {
 }
 
 func ValidateGenesis(data GenesisState) error {
+	validators := make(map[string]bool)
+	for _, valAddr := range data.Whitelist {
+		if valAddr.Empty() {
+			return fmt.Errorf("empty validator address in genesis whitelist")
+		}
+		if validators[valAddr.String()] {
+			return fmt.Errorf("duplicated validator address in genesis whitelist: %s", valAddr)
+		}
+		validators[valAddr.String()] = true
+	}
 	return nil
 }
@@ -21,7 +21,7 @@ var _ params.ParamSet = (*Params)(nil)
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyApprover, &p.Approver},
+		{Key: KeyApprover, Value: &p.Approver},
 	}
 }
 